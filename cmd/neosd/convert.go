@@ -0,0 +1,469 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+type status int
+
+const (
+	converted status = iota
+	skipped
+	failed
+)
+
+func (s status) String() string {
+	return [...]string{"Converted", "Skipped", "Failed"}[s]
+}
+
+type result struct {
+	status
+	detail string
+	report *neo.ConversionReport
+}
+
+// setReport is how the conversion of each ROM set is shown with --report
+type setReport struct {
+	ROMSet  string           `json:"rom_set"`
+	Output  string           `json:"output,omitempty"`
+	Error   string           `json:"error,omitempty"`
+	Missing []neo.MissingROM `json:"missing,omitempty"`
+	*neo.ConversionReport
+}
+
+// romPath splits a MAME-style list of directories
+func romPath(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ';' || r == filepath.ListSeparator
+	})
+}
+
+// nodumpFiles parses ROM=PATH pairs naming the file to use for a ROM image
+// that was never dumped
+func nodumpFiles(pairs []string) (map[string]string, error) {
+	files := make(map[string]string)
+	for _, pair := range pairs {
+		i := strings.IndexByte(pair, '=')
+		if i <= 0 || i == len(pair)-1 {
+			return nil, fmt.Errorf("invalid --nodump %s, expected ROM=PATH", pair)
+		}
+		files[pair[:i]] = pair[i+1:]
+	}
+	return files, nil
+}
+
+// romSets expands any directory in paths that contains zip archives or
+// further directories into those ROM sets, a directory containing only
+// files is assumed to be a ROM set itself
+func romSets(paths []string) ([]string, error) {
+	var sets []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			// Clones can be converted from a merged parent set
+			if os.IsNotExist(err) {
+				sets = append(sets, path)
+				continue
+			}
+			return nil, err
+		}
+
+		if !fi.IsDir() {
+			sets = append(sets, path)
+			continue
+		}
+
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var found []string
+		for _, i := range infos {
+			if i.IsDir() || strings.EqualFold(filepath.Ext(i.Name()), ".zip") {
+				found = append(found, filepath.Join(path, i.Name()))
+			}
+		}
+
+		if len(found) == 0 {
+			found = append(found, path)
+		}
+
+		sets = append(sets, found...)
+	}
+	return sets, nil
+}
+
+// outputPath returns where the .neo file for the ROM set at path is written
+func outputPath(dir, path string) string {
+	return filepath.Join(dir, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))+neo.Extension)
+}
+
+// checkOutputs returns an error if more than one ROM set would be written to
+// the same .neo file, such as a zip archive and a directory of the same name.
+// Names differing only by case are treated the same
+func checkOutputs(dir string, sets []string) error {
+	seen := make(map[string]string)
+	for _, set := range sets {
+		output := outputPath(dir, set)
+		if other, ok := seen[strings.ToLower(output)]; ok {
+			return fmt.Errorf("%s and %s would both be written to %s", other, set, output)
+		}
+		seen[strings.ToLower(output)] = set
+	}
+	return nil
+}
+
+// metadataFlags override the metadata of the converted ROM set
+var metadataFlags = []string{"name", "manufacturer", "year", "genre", "screenshot"}
+
+// convertAll converts each ROM set with up to jobs at once. A ROM set is
+// skipped if ctx is cancelled before it starts or it's unsupported
+func convertAll(ctx context.Context, jobs int, sets []string, convert func(context.Context, string) (string, *neo.ConversionReport, error)) ([]result, []error) {
+	results := make([]result, len(sets))
+	errs := make([]error, len(sets))
+
+	ch := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ch {
+				var output string
+				var report *neo.ConversionReport
+				err := ctx.Err()
+				if err == nil {
+					output, report, err = convert(ctx, sets[i])
+				}
+				switch {
+				case err == nil:
+					results[i] = result{converted, output, report}
+				case ctx.Err() != nil && errors.Is(err, ctx.Err()):
+					results[i] = result{skipped, "interrupted", nil}
+				case errors.Is(err, neo.ErrUnsupported):
+					results[i] = result{skipped, err.Error(), nil}
+				default:
+					results[i] = result{failed, err.Error(), nil}
+				}
+				errs[i] = err
+			}
+		}()
+	}
+
+	for i := range sets {
+		ch <- i
+	}
+	close(ch)
+
+	wg.Wait()
+
+	return results, errs
+}
+
+func convertSet(ctx context.Context, c *cli.Context, idx *neo.Index, path string, opts *neo.Options) (string, *neo.ConversionReport, error) {
+	n, report, err := idx.Convert(ctx, path, opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if c.IsSet("name") {
+		n.Name = c.String("name")
+	}
+
+	if c.IsSet("manufacturer") {
+		n.Manufacturer = c.String("manufacturer")
+	}
+
+	if c.IsSet("year") {
+		n.Year = uint32(c.Uint("year"))
+	}
+
+	if c.IsSet("genre") {
+		n.Genre = neo.Genre(c.Uint("genre"))
+	}
+
+	if c.IsSet("screenshot") {
+		n.Screenshot = uint32(c.Uint("screenshot"))
+	}
+
+	b, err := n.MarshalBinary()
+	if err != nil {
+		return "", nil, err
+	}
+
+	output := outputPath(c.String("directory"), path)
+
+	if err := ioutil.WriteFile(output, b, os.ModePerm); err != nil {
+		return "", nil, err
+	}
+
+	return output, report, nil
+}
+
+// addClones adds the clones found in each ROM set, such as a merged set,
+// that aren't already being converted
+func addClones(idx *neo.Index, sets []string) ([]string, error) {
+	seen := make(map[string]bool)
+	for _, set := range sets {
+		seen[strings.TrimSuffix(filepath.Base(set), filepath.Ext(set))] = true
+	}
+
+	var all []string
+	for _, set := range sets {
+		all = append(all, set)
+
+		clones, err := idx.Clones(set)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, clone := range clones {
+			name := strings.TrimSuffix(filepath.Base(clone), filepath.Ext(clone))
+			if !seen[name] {
+				seen[name] = true
+				all = append(all, clone)
+			}
+		}
+	}
+	return all, nil
+}
+
+// printMissing lists the ROM images that could not be found for each ROM
+// set
+func printMissing(sets []string, errs []error) {
+	for i, err := range errs {
+		var merr *neo.MissingROMError
+		if !errors.As(err, &merr) {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "%s: missing ROM images for %s\n", sets[i], merr.Game)
+
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetBorder(false)
+		table.SetAutoWrapText(false)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+
+		table.SetHeader([]string{"Area", "Filename", "Size", "CRC", "Belongs To"})
+
+		for _, r := range merr.ROMs {
+			owner := r.Game
+			if r.Parent {
+				owner += " (parent)"
+			}
+			table.Append([]string{neo.AreaName(r.Area), r.Filename, strconv.FormatUint(r.Size, 10), r.CRC.String(), owner})
+		}
+
+		table.Render()
+		fmt.Fprintln(os.Stderr)
+	}
+}
+
+func printResults(sets []string, results []result) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+
+	table.SetHeader([]string{"ROM Set", "Result", "Detail"})
+
+	for i, r := range results {
+		table.Append([]string{sets[i], r.status.String(), r.detail})
+	}
+
+	table.Render()
+}
+
+func printWarnings(sets []string, results []result) {
+	for i, r := range results {
+		if r.report == nil {
+			continue
+		}
+		for _, w := range r.report.Warnings {
+			if len(sets) > 1 {
+				w = sets[i] + ": " + w
+			}
+			fmt.Fprintln(os.Stderr, w)
+		}
+	}
+}
+
+func printReports(w io.Writer, sets []string, results []result, errs []error) error {
+	reports := make([]setReport, len(sets))
+	for i, r := range results {
+		reports[i] = setReport{ROMSet: sets[i], ConversionReport: r.report}
+		if r.status == converted {
+			reports[i].Output = r.detail
+		} else {
+			reports[i].Error = r.detail
+		}
+
+		var merr *neo.MissingROMError
+		if errors.As(errs[i], &merr) {
+			reports[i].Missing = merr.ROMs
+		}
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+
+	// Keep a single ROM set simple
+	if len(reports) == 1 {
+		return e.Encode(reports[0])
+	}
+	return e.Encode(reports)
+}
+
+func printSources(sets []string, results []result) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+
+	table.SetHeader([]string{"ROM Set", "Area", "ROM", "Source", "File"})
+
+	for i, r := range results {
+		if r.report == nil {
+			continue
+		}
+		for _, s := range r.report.Sources {
+			table.Append([]string{sets[i], neo.AreaName(s.Area), s.Filename, s.Source, s.File})
+		}
+	}
+
+	table.Render()
+	fmt.Println()
+}
+
+func convert(c *cli.Context) error {
+	if c.NArg() < 1 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	switch c.String("report") {
+	case "", "json":
+	default:
+		return cli.NewExitError("unknown report format "+c.String("report"), 1)
+	}
+
+	var scheme *neo.Scheme
+	if c.IsSet("scheme") {
+		var err error
+		if scheme, err = neo.ParseScheme(c.String("scheme")); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		scheme.GfxKey, scheme.M1 = int(c.Uint("gfx-key")), c.Bool("m1")
+	} else if c.IsSet("gfx-key") || c.IsSet("m1") {
+		return cli.NewExitError("--gfx-key and --m1 need --scheme", 1)
+	}
+
+	nodump, err := nodumpFiles(c.StringSlice("nodump"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	sets, err := romSets(c.Args().Slice())
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// Share checksums between ROM sets, particularly parents and clones
+	idx := neo.NewIndex(romPath(c.String("rompath"))...)
+
+	if c.Bool("all-clones") {
+		if sets, err = addClones(idx, sets); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	}
+
+	for _, flag := range metadataFlags {
+		if c.IsSet(flag) && len(sets) > 1 {
+			return cli.NewExitError("--"+flag+" needs a single ROM set", 1)
+		}
+	}
+
+	if err := checkOutputs(c.String("directory"), sets); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	jobs := c.Int("jobs")
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	// Stop cleanly on Ctrl-C
+	ctx, stop := signal.NotifyContext(c.Context, os.Interrupt)
+	defer stop()
+
+	pl := newProgressLine()
+
+	results, errs := convertAll(ctx, jobs, sets, func(ctx context.Context, set string) (string, *neo.ConversionReport, error) {
+		return convertSet(ctx, c, idx, set, &neo.Options{
+			Progress:      pl.progress(set),
+			AllowMismatch: c.Bool("allow-mismatch"),
+			Scheme:        scheme,
+			Nodump:        nodump,
+		})
+	})
+
+	pl.clear()
+
+	switch c.String("report") {
+	case "":
+		printWarnings(sets, results)
+		printMissing(sets, errs)
+		if c.Bool("verbose") {
+			printSources(sets, results)
+		}
+	case "json":
+		if err := printReports(os.Stdout, sets, results, errs); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	}
+
+	var count [failed + 1]int
+	for _, r := range results {
+		count[r.status]++
+	}
+
+	// The JSON report already includes the results
+	if !c.IsSet("report") {
+		printResults(sets, results)
+		fmt.Printf("\n%d converted, %d skipped, %d failed\n", count[converted], count[skipped], count[failed])
+	}
+
+	// A single ROM set fails with its own error, even if it's unsupported
+	if len(sets) == 1 && errs[0] != nil && ctx.Err() == nil {
+		return cli.NewExitError(errs[0], 1)
+	}
+
+	if ctx.Err() != nil {
+		return cli.NewExitError("interrupted", 130)
+	}
+
+	if count[failed] > 0 {
+		return cli.NewExitError(strconv.Itoa(count[failed])+" ROM sets failed to convert", 1)
+	}
+
+	return nil
+}
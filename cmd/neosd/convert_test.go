@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+// testApp returns the app without it exiting on an error
+func testApp() *cli.App {
+	app := newApp()
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	return app
+}
+
+func TestConvertAll(t *testing.T) {
+	sets := []string{"maglord.zip", "kof99.zip", "bad.zip", "mslug.zip", "kof98"}
+
+	var mu sync.Mutex
+	var running, most int
+
+	results, errs := convertAll(context.Background(), 2, sets, func(ctx context.Context, set string) (string, *neo.ConversionReport, error) {
+		mu.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		switch set {
+		case "kof99.zip":
+			return "", nil, fmt.Errorf("%s: %w", set, neo.ErrUnsupported)
+		case "bad.zip":
+			return "", nil, errors.New("bad")
+		}
+		return outputPath("", set), &neo.ConversionReport{Game: set}, nil
+	})
+
+	assert.Equal(t, 2, most)
+	assert.Equal(t, []result{
+		{converted, "maglord.neo", &neo.ConversionReport{Game: "maglord.zip"}},
+		{skipped, "kof99.zip: neo: unsupported game", nil},
+		{failed, "bad", nil},
+		{converted, "mslug.neo", &neo.ConversionReport{Game: "mslug.zip"}},
+		{converted, "kof98.neo", &neo.ConversionReport{Game: "kof98"}},
+	}, results)
+	assert.True(t, errors.Is(errs[1], neo.ErrUnsupported))
+	assert.Nil(t, errs[0])
+
+	// Nothing is started once cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, errs = convertAll(ctx, 2, sets, func(ctx context.Context, set string) (string, *neo.ConversionReport, error) {
+		t.Errorf("%s converted", set)
+		return "", nil, nil
+	})
+	for i := range sets {
+		assert.Equal(t, result{skipped, "interrupted", nil}, results[i])
+		assert.Equal(t, context.Canceled, errs[i])
+	}
+}
+
+func TestCheckOutputs(t *testing.T) {
+	assert.Nil(t, checkOutputs("out", []string{"roms/kof98.zip", "roms/kof99.zip"}))
+
+	err := checkOutputs("out", []string{"roms/kof98.zip", "roms/kof99.zip", "hacks/kof98"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "roms/kof98.zip and hacks/kof98 would both be written to "+filepath.Join("out", "kof98.neo"), err.Error())
+	}
+
+	assert.NotNil(t, checkOutputs("out", []string{"KOF98.zip", "kof98.zip"}))
+}
+
+func TestConvertMetadataFlags(t *testing.T) {
+	err := testApp().Run([]string{"neosd", "convert", "--name", "Hack", "maglord.zip", "kof98.zip"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "--name needs a single ROM set", err.Error())
+	}
+}
+
+func TestPrintReports(t *testing.T) {
+	sets := []string{"maglord.zip", "kof99.zip"}
+	results := []result{
+		{converted, "maglord.neo", &neo.ConversionReport{Method: neo.MethodMAME, Game: "maglord", Reader: "common"}},
+		{failed, "missing", nil},
+	}
+	errs := []error{nil, &neo.MissingROMError{Game: "kof99", ROMs: []neo.MissingROM{{Area: neo.P, Filename: "ka.neo-sma"}}}}
+
+	b := new(bytes.Buffer)
+	assert.Nil(t, printReports(b, sets, results, errs))
+
+	var reports []map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b.Bytes(), &reports))
+	if assert.Len(t, reports, 2) {
+		assert.Equal(t, "maglord.zip", reports[0]["rom_set"])
+		assert.Equal(t, "maglord.neo", reports[0]["output"])
+		assert.Equal(t, "mame", reports[0]["method"])
+		assert.Equal(t, "common", reports[0]["reader"])
+		assert.Nil(t, reports[0]["error"])
+
+		assert.Equal(t, "missing", reports[1]["error"])
+		assert.Len(t, reports[1]["missing"], 1)
+		assert.Nil(t, reports[1]["method"])
+	}
+
+	// A single ROM set isn't wrapped in an array
+	b.Reset()
+	assert.Nil(t, printReports(b, sets[:1], results[:1], errs[:1]))
+
+	var report map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b.Bytes(), &report))
+	assert.Equal(t, "maglord.zip", report["rom_set"])
+}
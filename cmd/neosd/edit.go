@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/urfave/cli/v2"
+)
+
+func edit(c *cli.Context) error {
+	if c.NArg() != 1 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	file, err := os.OpenFile(c.Args().First(), os.O_RDWR, 0)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	err = editHeader(c, file)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+// editHeader applies any metadata flags to the header of the open file
+func editHeader(c *cli.Context, file *os.File) error {
+	h, err := neo.ReadHeader(file)
+	if err != nil {
+		return err
+	}
+
+	if c.IsSet("name") {
+		h.Name = c.String("name")
+	}
+
+	if c.IsSet("manufacturer") {
+		h.Manufacturer = c.String("manufacturer")
+	}
+
+	if c.IsSet("year") {
+		h.Year = uint32(c.Uint("year"))
+	}
+
+	if c.IsSet("genre") {
+		h.Genre = neo.Genre(c.Uint("genre"))
+	}
+
+	if c.IsSet("screenshot") {
+		h.Screenshot = uint32(c.Uint("screenshot"))
+	}
+
+	if c.IsSet("ngh") {
+		h.NGH = uint32(c.Uint("ngh"))
+	}
+
+	return neo.UpdateHeader(file, h)
+}
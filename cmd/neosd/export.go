@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/urfave/cli/v2"
+)
+
+const misterRomSets = "romsets.xml"
+
+// addMiSTerRomSet adds or replaces the entry for a romset in the romsets.xml
+// file at path, creating it if necessary. Any comments in the file are lost
+func addMiSTerRomSet(path string, rs neo.MiSTerRomSet) error {
+	m := new(neo.MiSTerRomSets)
+
+	b, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := xml.Unmarshal(b, m); err != nil {
+			return err
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	m.Add(rs)
+
+	if b, err = xml.MarshalIndent(m, "", "\t"); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append([]byte(xml.Header), append(b, '\n')...), 0666)
+}
+
+func export(c *cli.Context) error {
+	if c.NArg() != 1 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	path := c.Args().First()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	f := new(neo.File)
+	if err := f.UnmarshalBinary(b); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	game := c.String("game")
+	if game == "" {
+		game = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	switch c.String("format") {
+	case "mame":
+		// Nothing is written if the game is refused
+		w := new(bytes.Buffer)
+		if err := f.ExportMAME(w, game); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(c.String("directory"), game+".zip"), w.Bytes(), os.ModePerm); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	case "darksoft":
+		if err := f.ExportDarksoft(filepath.Join(c.String("directory"), game)); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	case "mister":
+		if err := f.ExportMiSTer(filepath.Join(c.String("directory"), game)); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		if err := addMiSTerRomSet(filepath.Join(c.String("directory"), misterRomSets), f.MiSTerRomSet(game)); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	default:
+		return cli.NewExitError("unknown export format "+c.String("format"), 1)
+	}
+
+	return nil
+}
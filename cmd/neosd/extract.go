@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/urfave/cli/v2"
+)
+
+const metadataFile = "metadata.json"
+
+// metadata is the sidecar written alongside the extracted ROM areas
+type metadata struct {
+	Name         string    `json:"name"`
+	Manufacturer string    `json:"manufacturer"`
+	Year         uint32    `json:"year"`
+	Genre        neo.Genre `json:"genre"`
+	Screenshot   uint32    `json:"screenshot"`
+	NGH          uint32    `json:"ngh"`
+	Version      byte      `json:"version,omitempty"`
+	Reserved     string    `json:"reserved,omitempty"` // Hex encoded, less any trailing zeroes
+}
+
+func areaFilename(area int) string {
+	return strings.ToLower(neo.AreaName(area)) + ".bin"
+}
+
+func extractArea(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+func extract(c *cli.Context) error {
+	if c.NArg() != 1 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	path := c.Args().First()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	defer file.Close()
+
+	r, err := neo.Open(file)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	dir := c.String("directory")
+	if dir == "" {
+		dir = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	for i := 0; i < neo.Areas; i++ {
+		if r.Size[i] == 0 {
+			continue
+		}
+
+		if err := extractArea(filepath.Join(dir, areaFilename(i)), r.ROM[i]); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	}
+
+	b, err := json.MarshalIndent(metadata{
+		Name:         r.Name,
+		Manufacturer: r.Manufacturer,
+		Year:         r.Year,
+		Genre:        r.Genre,
+		Screenshot:   r.Screenshot,
+		NGH:          r.NGH,
+		Version:      r.Version,
+		Reserved:     hex.EncodeToString(bytes.TrimRight(r.Reserved[:], "\x00")),
+	}, "", "  ")
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, metadataFile), append(b, '\n'), os.ModePerm); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func pack(c *cli.Context) error {
+	if c.NArg() != 1 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	dir := filepath.Clean(c.Args().First())
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, metadataFile))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	var m metadata
+	if err := json.Unmarshal(b, &m); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	n := &neo.File{
+		Year:         m.Year,
+		Genre:        m.Genre,
+		Screenshot:   m.Screenshot,
+		NGH:          m.NGH,
+		Name:         m.Name,
+		Manufacturer: m.Manufacturer,
+	}
+
+	// Older sidecars have neither, which gives the defaults
+	n.Version = m.Version
+	reserved, err := hex.DecodeString(m.Reserved)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if len(reserved) > len(n.Reserved) {
+		return cli.NewExitError("reserved area is too long", 1)
+	}
+	copy(n.Reserved[:], reserved)
+
+	// A missing file is an empty area
+	for i := 0; i < neo.Areas; i++ {
+		b, err := ioutil.ReadFile(filepath.Join(dir, areaFilename(i)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return cli.NewExitError(err, 1)
+		}
+		n.ROM[i] = b
+		n.Size[i] = uint32(len(b))
+	}
+
+	if err := n.Header().Validate(); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	b, err = n.MarshalBinary()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(c.String("directory"), filepath.Base(dir)+neo.Extension), b, os.ModePerm); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractPack(t *testing.T) {
+	f := &neo.File{
+		Year:         1990,
+		Genre:        neo.Action,
+		Screenshot:   7,
+		NGH:          0x5,
+		Name:         "Magician Lord",
+		Manufacturer: "Alpha Denshi",
+	}
+	for i := 0; i < neo.Areas; i++ {
+		if i == neo.V2 {
+			continue
+		}
+		f.ROM[i] = bytes.Repeat([]byte{byte(i + 1)}, 0x100*(i+1))
+		f.Size[i] = uint32(len(f.ROM[i]))
+	}
+
+	b, err := f.MarshalBinary()
+	assert.Nil(t, err)
+	// Pretend a newer tool wrote this
+	b[3] = 2
+	b[0x100], b[0x200] = 0xaa, 0x55
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "maglord"+neo.Extension)
+	assert.Nil(t, ioutil.WriteFile(path, b, 0666))
+
+	extracted := filepath.Join(dir, "extracted", "maglord")
+	assert.Nil(t, testApp().Run([]string{"neosd", "extract", "--directory", extracted, path}))
+	_, err = ioutil.ReadFile(filepath.Join(extracted, areaFilename(neo.V2)))
+	assert.NotNil(t, err)
+
+	packed := t.TempDir()
+	assert.Nil(t, testApp().Run([]string{"neosd", "pack", "--directory", packed, extracted}))
+
+	c, err := ioutil.ReadFile(filepath.Join(packed, "maglord"+neo.Extension))
+	assert.Nil(t, err)
+	assert.Equal(t, b, c)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/urfave/cli/v2"
+)
+
+const definitionsFile = "games.json"
+
+// defaultDefinitions returns the path of the definitions file in the user's
+// configuration directory
+func defaultDefinitions() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "neosd", definitionsFile)
+}
+
+// loadDefinitions adds the user's game definitions to the games known to
+// MAME. The file in the configuration directory doesn't have to exist
+func loadDefinitions(c *cli.Context) error {
+	path := c.String("definitions")
+	if path == "" {
+		if path = defaultDefinitions(); path == "" {
+			return nil
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	defer file.Close()
+
+	if err := neo.LoadDefinitions(file); err != nil {
+		return cli.NewExitError(path+": "+err.Error(), 1)
+	}
+
+	return nil
+}
+
+// loadSoftwareList adds the games in a newer MAME software list to the
+// games known to MAME
+func loadSoftwareList(c *cli.Context) error {
+	path := c.String("softlist")
+	if path == "" {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	defer file.Close()
+
+	if err := neo.LoadSoftwareList(file); err != nil {
+		return cli.NewExitError(path+": "+err.Error(), 1)
+	}
+
+	return nil
+}
+
+// withGames loads any software list and then the user's game definitions
+// before running action
+func withGames(action cli.ActionFunc) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if err := loadSoftwareList(c); err != nil {
+			return err
+		}
+		if err := loadDefinitions(c); err != nil {
+			return err
+		}
+		return action(c)
+	}
+}
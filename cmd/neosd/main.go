@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"crypto/sha1"
 	"fmt"
 	"io"
@@ -9,6 +8,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 
@@ -31,23 +31,61 @@ func init() {
 	}
 }
 
-func romToString(r int) string {
-	switch r {
-	case neo.P:
-		return "P"
-	case neo.S:
-		return "S"
-	case neo.M:
-		return "M"
-	case neo.V1:
-		return "V1"
-	case neo.V2:
-		return "V2"
-	case neo.C:
-		return "C"
-	default:
-		return strconv.Itoa(r)
+// offsetRanges collapses a sorted list of offsets into runs such as
+// "0x100-0x103, 0x110"
+func offsetRanges(offsets []int) string {
+	var runs []string
+	for i := 0; i < len(offsets); {
+		j := i
+		for j+1 < len(offsets) && offsets[j+1] == offsets[j]+1 {
+			j++
+		}
+		if i == j {
+			runs = append(runs, fmt.Sprintf("0x%x", offsets[i]))
+		} else {
+			runs = append(runs, fmt.Sprintf("0x%x-0x%x", offsets[i], offsets[j]))
+		}
+		i = j + 1
 	}
+	return strings.Join(runs, ", ")
+}
+
+// neoFiles expands any directories in paths to the .neo files they contain
+func neoFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range infos {
+			if !i.IsDir() && strings.EqualFold(filepath.Ext(i.Name()), neo.Extension) {
+				files = append(files, filepath.Join(path, i.Name()))
+			}
+		}
+	}
+	return files, nil
+}
+
+func readHeader(path string) (neo.Header, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return neo.Header{}, err
+	}
+	defer file.Close()
+
+	return neo.ReadHeader(file)
 }
 
 func info(c *cli.Context) error {
@@ -55,13 +93,70 @@ func info(c *cli.Context) error {
 		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
 	}
 
-	b, err := ioutil.ReadFile(c.Args().First())
+	files, err := neoFiles(c.Args().Slice())
 	if err != nil {
 		return cli.NewExitError(err, 1)
 	}
 
-	f := new(neo.File)
-	if err := f.UnmarshalBinary(b); err != nil {
+	// A single file gets the detailed view, anything else gets one row per file
+	if c.NArg() == 1 && len(files) == 1 && files[0] == c.Args().First() {
+		return infoFile(c, files[0])
+	}
+
+	return infoList(c, files)
+}
+
+func infoList(c *cli.Context, files []string) error {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+
+	header := []string{"File", "Name", "Manufacturer", "Year", "Genre", "Screenshot", "NGH"}
+	if c.Bool("verbose") {
+		for i := 0; i < neo.Areas; i++ {
+			header = append(header, neo.AreaName(i))
+		}
+	}
+	table.SetHeader(header)
+
+	failed := 0
+	for _, file := range files {
+		h, err := readHeader(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			failed++
+			continue
+		}
+
+		row := []string{filepath.Base(file), h.Name, h.Manufacturer, strconv.FormatUint(uint64(h.Year), 10), h.Genre.String(), strconv.FormatUint(uint64(h.Screenshot), 10), fmt.Sprintf("0x%x", h.NGH)}
+		if c.Bool("verbose") {
+			for i := 0; i < neo.Areas; i++ {
+				row = append(row, strconv.FormatUint(uint64(h.Size[i]), 10))
+			}
+		}
+		table.Append(row)
+	}
+
+	table.Render()
+
+	if failed > 0 {
+		return cli.NewExitError(fmt.Sprintf("%d of %d files could not be read", failed, len(files)), 1)
+	}
+
+	return nil
+}
+
+func infoFile(c *cli.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	defer file.Close()
+
+	f, err := neo.Open(file)
+	if err != nil {
 		return cli.NewExitError(err, 1)
 	}
 
@@ -82,6 +177,17 @@ func info(c *cli.Context) error {
 	table.Append([]string{"Screenshot:", strconv.FormatUint(uint64(f.Screenshot), 10)})
 	table.Append([]string{"NGH:", fmt.Sprintf("0x%x", f.NGH)})
 
+	if c.Bool("verbose") {
+		table.Append([]string{"Version:", strconv.Itoa(int(f.Version))})
+
+		// Anything here was written by another tool
+		if offsets := f.NonZeroReserved(); len(offsets) > 0 {
+			table.Append([]string{"Reserved:", fmt.Sprintf("%d non-zero bytes at %s", len(offsets), offsetRanges(offsets))})
+		} else {
+			table.Append([]string{"Reserved:", "unused"})
+		}
+	}
+
 	table.Render()
 
 	if c.Bool("verbose") {
@@ -98,13 +204,13 @@ func info(c *cli.Context) error {
 			if f.Size[i] > 0 {
 				h := sha1.New()
 
-				if _, err := io.Copy(h, bytes.NewBuffer(f.ROM[i])); err != nil {
+				if _, err := io.Copy(h, f.ROM[i]); err != nil {
 					return cli.NewExitError(err, 1)
 				}
 
-				table.Append([]string{romToString(i), strconv.FormatUint(uint64(f.Size[i]), 10), fmt.Sprintf("%x", h.Sum(nil))})
+				table.Append([]string{neo.AreaName(i), strconv.FormatUint(uint64(f.Size[i]), 10), fmt.Sprintf("%x", h.Sum(nil))})
 			} else {
-				table.Append([]string{romToString(i), "0", "-"})
+				table.Append([]string{neo.AreaName(i), "0", "-"})
 			}
 		}
 
@@ -114,51 +220,7 @@ func info(c *cli.Context) error {
 	return nil
 }
 
-func convert(c *cli.Context) error {
-	if c.NArg() < 1 {
-		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
-	}
-
-	path := c.Args().First()
-
-	n, err := neo.NewFile(path)
-	if err != nil {
-		return cli.NewExitError(err, 1)
-	}
-
-	if c.IsSet("name") {
-		n.Name = c.String("name")
-	}
-
-	if c.IsSet("manufacturer") {
-		n.Manufacturer = c.String("manufacturer")
-	}
-
-	if c.IsSet("year") {
-		n.Year = uint32(c.Uint("year"))
-	}
-
-	if c.IsSet("genre") {
-		n.Genre = neo.Genre(c.Uint("genre"))
-	}
-
-	if c.IsSet("screenshot") {
-		n.Screenshot = uint32(c.Uint("screenshot"))
-	}
-
-	b, err := n.MarshalBinary()
-	if err != nil {
-		return cli.NewExitError(err, 1)
-	}
-
-	if err := ioutil.WriteFile(filepath.Join(c.String("directory"), strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))+neo.Extension), b, os.ModePerm); err != nil {
-		return cli.NewExitError(err, 1)
-	}
-
-	return nil
-}
-
-func main() {
+func newApp() *cli.App {
 	app := cli.NewApp()
 
 	app.Name = "neosd"
@@ -170,13 +232,27 @@ func main() {
 		log.Fatal(err)
 	}
 
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:        "definitions",
+			Usage:       "add the games defined in `FILE` to those known to MAME when converting or exporting",
+			EnvVars:     []string{"NEOSD_DEFINITIONS"},
+			DefaultText: defaultDefinitions(),
+		},
+		&cli.StringFlag{
+			Name:    "softlist",
+			Usage:   "add the games in the MAME software list `FILE` to those built in when converting or exporting",
+			EnvVars: []string{"NEOSD_SOFTLIST"},
+		},
+	}
+
 	app.Commands = []*cli.Command{
 		{
 			Name:        "info",
-			Usage:       "Info on a " + neo.Extension + " file",
-			Description: "",
+			Usage:       "Info on " + neo.Extension + " files",
+			Description: "With a single FILE every header field is shown, otherwise one row is shown for each FILE and each " + neo.Extension + " file found in any DIRECTORY",
 			Action:      info,
-			ArgsUsage:   "FILE",
+			ArgsUsage:   "FILE|DIRECTORY...",
 			Flags: []cli.Flag{
 				&cli.BoolFlag{
 					Name:    "verbose",
@@ -187,17 +263,61 @@ func main() {
 		},
 		{
 			Name:        "convert",
-			Usage:       "Create a " + neo.Extension + " file from an existing set of ROM images",
-			Description: "",
-			Action:      convert,
-			ArgsUsage:   "PATH",
+			Usage:       "Create " + neo.Extension + " files from existing sets of ROM images",
+			Description: "Each PATH is a zip archive or directory of ROM images, or a directory of such zip archives and directories which are all converted",
+			Action:      withGames(convert),
+			ArgsUsage:   "PATH...",
 			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    "jobs",
+					Aliases: []string{"j"},
+					Usage:   "convert `N` ROM sets at once",
+					Value:   runtime.NumCPU(),
+				},
 				&cli.StringFlag{
 					Name:    "directory",
 					Aliases: []string{"d"},
 					Usage:   "output directory",
 					Value:   cwd,
 				},
+				&cli.StringFlag{
+					Name:    "rompath",
+					Usage:   "also search `PATHS` for ROM sets, separated with semicolons",
+					EnvVars: []string{"NEOSD_ROMPATH"},
+				},
+				&cli.BoolFlag{
+					Name:    "verbose",
+					Aliases: []string{"v"},
+					Usage:   "show where each ROM image was found",
+				},
+				&cli.StringFlag{
+					Name:  "report",
+					Usage: "print how each ROM set was converted as `FORMAT`, only json is supported",
+				},
+				&cli.BoolFlag{
+					Name:  "allow-mismatch",
+					Usage: "use ROM images with the right filename or size if the checksum doesn't match",
+				},
+				&cli.StringFlag{
+					Name:  "scheme",
+					Usage: "decrypt ROM sets not known to MAME with `SCHEME`, one of cmc42, cmc50, pcm2:VALUE or sma:GAME",
+				},
+				&cli.UintFlag{
+					Name:  "gfx-key",
+					Usage: "use `KEY` to decrypt the C ROM with --scheme",
+				},
+				&cli.BoolFlag{
+					Name:  "m1",
+					Usage: "also decrypt the M1 ROM with --scheme",
+				},
+				&cli.StringSliceFlag{
+					Name:  "nodump",
+					Usage: "use the file at `ROM=PATH` for the ROM image ROM which MAME has never dumped, can be repeated",
+				},
+				&cli.BoolFlag{
+					Name:  "all-clones",
+					Usage: "also convert every clone whose ROM images are found in each ROM set",
+				},
 				&cli.StringFlag{
 					Name:  "name",
 					Usage: "override name with `NAME`",
@@ -220,9 +340,126 @@ func main() {
 				},
 			},
 		},
+		{
+			Name:        "edit",
+			Usage:       "Change the metadata in an existing " + neo.Extension + " file",
+			Description: "Only the header is rewritten, the ROM images are left untouched",
+			Action:      edit,
+			ArgsUsage:   "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Usage: "set name to `NAME`",
+				},
+				&cli.StringFlag{
+					Name:  "manufacturer",
+					Usage: "set manufacturer to `MANUFACTURER`",
+				},
+				&cli.UintFlag{
+					Name:  "year",
+					Usage: "set year to `YEAR`",
+				},
+				&cli.UintFlag{
+					Name:  "genre",
+					Usage: "set genre to `GENRE`",
+				},
+				&cli.UintFlag{
+					Name:  "screenshot",
+					Usage: "set screenshot to `SCREENSHOT`",
+				},
+				&cli.UintFlag{
+					Name:  "ngh",
+					Usage: "set NGH number to `NGH`",
+				},
+			},
+		},
+		{
+			Name:        "extract",
+			Usage:       "Extract the ROM images from a " + neo.Extension + " file",
+			Description: "Each non-empty ROM image is written as p.bin, s.bin, m.bin, v1.bin, v2.bin or c.bin along with the metadata in " + metadataFile,
+			Action:      extract,
+			ArgsUsage:   "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "directory",
+					Aliases:     []string{"d"},
+					Usage:       "output directory",
+					DefaultText: "FILE minus any extension",
+				},
+			},
+		},
+		{
+			Name:        "pack",
+			Usage:       "Create a " + neo.Extension + " file from a directory of extracted ROM images",
+			Description: "This is the reverse of the extract command, any missing ROM images are left empty",
+			Action:      pack,
+			ArgsUsage:   "DIRECTORY",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "directory",
+					Aliases: []string{"d"},
+					Usage:   "output directory",
+					Value:   cwd,
+				},
+			},
+		},
+		{
+			Name:        "export",
+			Usage:       "Create a MAME-style zip archive or other layout of ROM images from a " + neo.Extension + " file",
+			Description: "With the mame format the ROM images are split using the filenames and sizes MAME uses for GAME, games that MAME decrypts, rearranges or patches are refused. With the darksoft format a directory named GAME is created for the Darksoft multi-cart. The mister format creates a directory named GAME using the filenames and C ROM pairs read by the MiSTer Neo Geo core and adds GAME to the romsets.xml file in the output directory",
+			Action:      withGames(export),
+			ArgsUsage:   "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "directory",
+					Aliases: []string{"d"},
+					Usage:   "output directory",
+					Value:   cwd,
+				},
+				&cli.StringFlag{
+					Name:        "game",
+					Usage:       "use the MAME ROM layout of `GAME` or name the directory after it",
+					DefaultText: "FILE minus any extension",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "write the ROM images as `FORMAT`, one of mame, darksoft or mister",
+					Value: "mame",
+				},
+			},
+		},
+		{
+			Name:        "softlist-diff",
+			Usage:       "Compare two MAME software lists",
+			Description: "Lists the games, ROM images and data area sizes that changed between OLD and NEW, the games and slot types the generator now includes or excludes, and the entries in the readers table for games that are no longer included or whose reader doesn't exist",
+			Action:      softlistDiff,
+			ArgsUsage:   "OLD NEW",
+		},
+		{
+			Name:        "verify",
+			Usage:       "Verify " + neo.Extension + " files against the expected checksums",
+			Description: "Each ROM area is compared against the SHA1 checksum of the decrypted area for the game. No checksums are built in yet so they need loading from a file of \"game area sha1\" lines, the format used by neo/sha1.txt",
+			Action:      verify,
+			ArgsUsage:   "FILE...",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "checksums",
+					Usage: "load the expected checksums from `FILE`",
+				},
+				&cli.StringFlag{
+					Name:        "game",
+					Usage:       "verify against `GAME`",
+					DefaultText: "FILE minus any extension",
+				},
+			},
+		},
 	}
 
-	if err := app.Run(os.Args); err != nil {
+	return app
+}
+
+func main() {
+	if err := newApp().Run(os.Args); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bodgit/terraonion/neo"
+)
+
+// progressLine shows the latest progress of any running conversion on a
+// single line that is redrawn in place
+type progressLine struct {
+	mu    sync.Mutex
+	w     io.Writer
+	last  time.Time
+	width int
+}
+
+// newProgressLine returns nil unless stderr is a terminal
+func newProgressLine() *progressLine {
+	if fi, err := os.Stderr.Stat(); err != nil || fi.Mode()&os.ModeCharDevice == 0 {
+		return nil
+	}
+	return &progressLine{w: os.Stderr}
+}
+
+func (pl *progressLine) update(set string, p neo.Progress) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	// Don't redraw more than necessary
+	if time.Since(pl.last) < 100*time.Millisecond {
+		return
+	}
+	pl.last = time.Now()
+
+	percent := uint64(100)
+	if p.Total > 0 {
+		percent = p.Done * 100 / p.Total
+	}
+
+	pl.print(fmt.Sprintf("%s: %s %s %d%%", filepath.Base(set), neo.AreaName(p.Area), p.Stage, percent))
+}
+
+func (pl *progressLine) print(s string) {
+	fmt.Fprintf(pl.w, "\r%-*s", pl.width, s)
+	pl.width = len(s)
+}
+
+// clear removes the line so other output isn't mixed up with it
+func (pl *progressLine) clear() {
+	if pl == nil {
+		return
+	}
+
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	if pl.width > 0 {
+		fmt.Fprintf(pl.w, "\r%*s\r", pl.width, "")
+		pl.width = 0
+	}
+}
+
+// progress returns the progress callback for a ROM set
+func (pl *progressLine) progress(set string) func(neo.Progress) {
+	if pl == nil {
+		return nil
+	}
+	return func(p neo.Progress) {
+		pl.update(set, p)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/bodgit/terraonion/internal/softlist"
+	"github.com/bodgit/terraonion/neo"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+func readSoftwareList(path string) (*softlist.SoftwareLists, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	lists, err := softlist.Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return lists, nil
+}
+
+func newTable(header ...string) *tablewriter.Table {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetHeader(header)
+	return table
+}
+
+// romDetails returns the size and CRC of a ROM image for a table
+func romDetails(r *softlist.ROM) (string, string) {
+	switch {
+	case r == nil:
+		return "-", "-"
+	case r.IsNodump():
+		return strconv.FormatUint(uint64(r.Size), 10), "nodump"
+	default:
+		return strconv.FormatUint(uint64(r.Size), 10), r.CRC
+	}
+}
+
+func supported(b bool) string {
+	if b {
+		return "supported"
+	}
+	return "unsupported"
+}
+
+func softlistDiff(c *cli.Context) error {
+	if c.NArg() != 2 {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	oldList, err := readSoftwareList(c.Args().Get(0))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	newList, err := readSoftwareList(c.Args().Get(1))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	d := softlist.Compare(oldList, newList, neo.ReaderNames())
+
+	sections := []struct {
+		title string
+		table *tablewriter.Table
+		rows  int
+	}{
+		{"Added games", newTable("Game"), len(d.Added)},
+		{"Removed games", newTable("Game"), len(d.Removed)},
+		{"Changed ROM images", newTable("Game", "Area", "Filename", "Old Size", "Old CRC", "New Size", "New CRC"), len(d.ROMs)},
+		{"Changed data areas", newTable("Game", "Area", "Old Size", "New Size"), len(d.Areas)},
+		{"Games changing support", newTable("Game", "Old Slot", "New Slot", "Now"), len(d.Support)},
+		{"New slot types", newTable("Slot", "Now"), len(d.NewSlots)},
+		{"Readers table entries that no longer resolve", newTable("Game", "Reader", "Problem"), len(d.Unresolved)},
+	}
+
+	for _, game := range d.Added {
+		sections[0].table.Append([]string{game})
+	}
+
+	for _, game := range d.Removed {
+		sections[1].table.Append([]string{game})
+	}
+
+	for _, r := range d.ROMs {
+		rom := r.New
+		if r.Old != nil {
+			rom = r.Old
+		}
+		oldSize, oldCRC := romDetails(r.Old)
+		newSize, newCRC := romDetails(r.New)
+		sections[2].table.Append([]string{r.Game, r.Area, rom.Name, oldSize, oldCRC, newSize, newCRC})
+	}
+
+	for _, a := range d.Areas {
+		sections[3].table.Append([]string{a.Game, a.Area, strconv.FormatUint(uint64(a.OldSize), 10), strconv.FormatUint(uint64(a.NewSize), 10)})
+	}
+
+	for _, s := range d.Support {
+		sections[4].table.Append([]string{s.Game, s.OldSlot, s.NewSlot, supported(s.Supported)})
+	}
+
+	slots := make([]string, 0, len(d.NewSlots))
+	for slot := range d.NewSlots {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+	for _, slot := range slots {
+		sections[5].table.Append([]string{slot, supported(d.NewSlots[slot])})
+	}
+
+	for _, r := range d.Unresolved {
+		problem := "game not included"
+		if r.Unknown {
+			problem = "no such reader"
+		}
+		sections[6].table.Append([]string{r.Game, r.Reader, problem})
+	}
+
+	for _, s := range sections {
+		if s.rows == 0 {
+			continue
+		}
+		fmt.Printf("%s:\n\n", s.title)
+		s.table.Render()
+		fmt.Println()
+	}
+
+	return nil
+}
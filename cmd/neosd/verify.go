@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bodgit/terraonion/neo"
+	"github.com/urfave/cli/v2"
+)
+
+func verifyFile(path, game string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := neo.Open(file)
+	if err != nil {
+		return err
+	}
+
+	return r.Verify(game)
+}
+
+func loadChecksums(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return neo.LoadChecksums(file)
+}
+
+func verify(c *cli.Context) error {
+	if c.NArg() < 1 || (c.IsSet("game") && c.NArg() > 1) {
+		cli.ShowCommandHelpAndExit(c, c.Command.FullName(), 1)
+	}
+
+	if path := c.String("checksums"); path != "" {
+		if err := loadChecksums(path); err != nil {
+			return cli.NewExitError(path+": "+err.Error(), 1)
+		}
+	}
+
+	failed := 0
+	for _, path := range c.Args().Slice() {
+		game := c.String("game")
+		if game == "" {
+			game = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+
+		err := verifyFile(path, game)
+		if err == nil {
+			fmt.Printf("%s: OK\n", path)
+			continue
+		}
+
+		failed++
+
+		var verr *neo.VerifyError
+		switch {
+		case errors.Is(err, neo.ErrNoChecksums):
+			fmt.Printf("%s: no expected checksums for %s, pass them with --checksums\n", path, game)
+		case errors.As(err, &verr):
+			for _, area := range verr.Areas {
+				fmt.Printf("%s: %s area differs from %s\n", path, neo.AreaName(area), verr.Game)
+			}
+		default:
+			fmt.Printf("%s: %v\n", path, err)
+		}
+	}
+
+	if failed > 0 {
+		return cli.NewExitError(fmt.Sprintf("%d of %d files failed verification", failed, c.NArg()), 1)
+	}
+
+	return nil
+}
@@ -1,6 +1,6 @@
 module github.com/bodgit/terraonion
 
-go 1.13
+go 1.16
 
 require (
 	github.com/bodgit/plumbing v0.0.0-20200416225550-8a3ceab39dc5
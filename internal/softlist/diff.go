@@ -0,0 +1,214 @@
+package softlist
+
+import (
+	"sort"
+	"strings"
+)
+
+// games returns every game in the software lists by name
+func (l *SoftwareLists) games() map[string]Software {
+	games := make(map[string]Software)
+	for _, list := range l.SoftwareList {
+		for _, s := range list.Software {
+			games[s.Name] = s
+		}
+	}
+	return games
+}
+
+// ROMChange is a ROM image that was added, removed or changed between two
+// software lists. Old or New is nil if the ROM image was added or removed
+type ROMChange struct {
+	Game string
+	Area string
+	Old  *ROM
+	New  *ROM
+}
+
+// SupportChange is a game that the generator now includes or excludes
+type SupportChange struct {
+	Game      string
+	OldSlot   string
+	NewSlot   string
+	Supported bool
+}
+
+// AreaChange is a data area whose size changed between two software lists
+type AreaChange struct {
+	Game    string
+	Area    string
+	OldSize Size
+	NewSize Size
+}
+
+// UnresolvedReader is an entry in the readers table for a game that isn't
+// included by the generator any more or for a reader that doesn't exist
+type UnresolvedReader struct {
+	Game    string
+	Reader  string
+	Unknown bool // The game is included but there's no such reader
+}
+
+// Diff is what changed between two software lists
+type Diff struct {
+	Added      []string
+	Removed    []string
+	ROMs       []ROMChange
+	Areas      []AreaChange
+	Support    []SupportChange
+	NewSlots   map[string]bool // Slot types not seen before and whether they're supported
+	Unresolved []UnresolvedReader
+}
+
+// romsIn returns the named ROM images in a data area in order
+func (s Software) romsIn(area string) []ROM {
+	var roms []ROM
+	for _, da := range s.DataArea {
+		if da.Name != area {
+			continue
+		}
+		for _, r := range da.ROM {
+			if r.Name != "" {
+				roms = append(roms, r)
+			}
+		}
+	}
+	return roms
+}
+
+func romsByName(roms []ROM) map[string]*ROM {
+	m := make(map[string]*ROM)
+	for i := range roms {
+		m[roms[i].Name] = &roms[i]
+	}
+	return m
+}
+
+// diffROMs compares the ROM images of a game in each data area by filename
+func diffROMs(old, new Software) []ROMChange {
+	names := make(map[string]struct{})
+	for _, s := range []Software{old, new} {
+		for _, da := range s.DataArea {
+			names[da.Name] = struct{}{}
+		}
+	}
+
+	areas := make([]string, 0, len(names))
+	for name := range names {
+		areas = append(areas, name)
+	}
+	sort.Strings(areas)
+
+	var changes []ROMChange
+
+	for _, area := range areas {
+		oldROMs, newROMs := old.romsIn(area), new.romsIn(area)
+		oldByName, newByName := romsByName(oldROMs), romsByName(newROMs)
+
+		for i := range oldROMs {
+			r := &oldROMs[i]
+			n, ok := newByName[r.Name]
+			switch {
+			case !ok:
+				changes = append(changes, ROMChange{old.Name, area, r, nil})
+			case n.Size != r.Size || !strings.EqualFold(n.CRC, r.CRC) || n.IsNodump() != r.IsNodump():
+				changes = append(changes, ROMChange{old.Name, area, r, n})
+			}
+		}
+		for i := range newROMs {
+			if _, ok := oldByName[newROMs[i].Name]; !ok {
+				changes = append(changes, ROMChange{new.Name, area, nil, &newROMs[i]})
+			}
+		}
+	}
+
+	return changes
+}
+
+// diffAreas compares the sizes of the data areas of a game
+func diffAreas(old, new Software) []AreaChange {
+	var changes []AreaChange
+	for _, o := range old.DataArea {
+		for _, n := range new.DataArea {
+			if o.Name == n.Name && o.Size != n.Size {
+				changes = append(changes, AreaChange{new.Name, n.Name, o.Size, n.Size})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Area < changes[j].Area
+	})
+
+	return changes
+}
+
+// Compare lists what changed between the old and new software lists. Games
+// are listed in name order. The readers table is checked against the names
+// of the readers that exist
+func Compare(old, new *SoftwareLists, readerNames []string) Diff {
+	oldGames, newGames := old.games(), new.games()
+
+	names := make([]string, 0, len(oldGames)+len(newGames))
+	for name := range oldGames {
+		names = append(names, name)
+	}
+	for name := range newGames {
+		if _, ok := oldGames[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	oldSlots := make(map[string]struct{})
+	for _, s := range oldGames {
+		oldSlots[s.Slot()] = struct{}{}
+	}
+
+	d := Diff{
+		NewSlots: make(map[string]bool),
+	}
+
+	for _, name := range names {
+		o, inOld := oldGames[name]
+		n, inNew := newGames[name]
+
+		switch {
+		case !inOld:
+			d.Added = append(d.Added, name)
+		case !inNew:
+			d.Removed = append(d.Removed, name)
+		default:
+			d.ROMs = append(d.ROMs, diffROMs(o, n)...)
+			d.Areas = append(d.Areas, diffAreas(o, n)...)
+			if o.IsSupported() != n.IsSupported() {
+				d.Support = append(d.Support, SupportChange{name, o.Slot(), n.Slot(), n.IsSupported()})
+			}
+		}
+
+		if _, ok := oldSlots[n.Slot()]; inNew && !ok && n.Slot() != "" {
+			d.NewSlots[n.Slot()] = n.IsSupportedSlot()
+		}
+	}
+
+	known := make(map[string]struct{})
+	for _, name := range readerNames {
+		known[name] = struct{}{}
+	}
+
+	games := make([]string, 0, len(readers))
+	for name := range readers {
+		games = append(games, name)
+	}
+	sort.Strings(games)
+
+	for _, name := range games {
+		if s, ok := newGames[name]; !ok || !s.IsSupported() {
+			d.Unresolved = append(d.Unresolved, UnresolvedReader{name, readers[name], false})
+		} else if _, ok := known[readers[name]]; !ok {
+			d.Unresolved = append(d.Unresolved, UnresolvedReader{name, readers[name], true})
+		}
+	}
+
+	return d
+}
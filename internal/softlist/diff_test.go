@@ -0,0 +1,48 @@
+package softlist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	oldList, err := Read(strings.NewReader(`<softwarelists><softwarelist name="neogeo">
+<software name="kof99"><part name="cart" interface="neo_cart"><feature name="slot" value="sma_kof99" />
+<dataarea name="sprites" size="0x100000"><rom name="c1" size="0x80000" crc="12345678" /><rom name="c2" size="0x80000" status="nodump" /></dataarea>
+</part></software>
+<software name="gone"><part name="cart" interface="neo_cart" /></software>
+<software name="kof98"><part name="cart" interface="neo_cart"><feature name="slot" value="rom_kof98" />
+<dataarea name="maincpu" size="0x400000"><rom name="p1" size="0x100000" crc="11111111" /></dataarea>
+</part></software>
+</softwarelist></softwarelists>`))
+	assert.Nil(t, err)
+
+	newList, err := Read(strings.NewReader(`<softwarelists><softwarelist name="neogeo">
+<software name="kof99"><part name="cart" interface="neo_cart"><feature name="slot" value="sma_kof99_v2" />
+<dataarea name="sprites" size="0x100000"><rom name="c1" size="0x80000" crc="12345678" /><rom name="c2" size="0x80000" crc="87654321" /></dataarea>
+</part></software>
+<software name="added"><part name="cart" interface="neo_cart" /></software>
+<software name="kof98"><part name="cart" interface="neo_cart"><feature name="slot" value="rom_kof98" />
+<dataarea name="maincpu" size="0x500000"><rom name="p1" size="0x100000" crc="11111111" /></dataarea>
+</part></software>
+</softwarelist></softwarelists>`))
+	assert.Nil(t, err)
+
+	d := Compare(oldList, newList, []string{"common", "kof99"})
+
+	assert.Equal(t, []string{"added"}, d.Added)
+	assert.Equal(t, []string{"gone"}, d.Removed)
+	if assert.Len(t, d.ROMs, 1) {
+		assert.Equal(t, "c2", d.ROMs[0].Old.Name)
+		assert.True(t, d.ROMs[0].Old.IsNodump())
+		assert.Equal(t, "87654321", d.ROMs[0].New.CRC)
+	}
+	assert.Equal(t, []SupportChange{{"kof99", "sma_kof99", "sma_kof99_v2", false}}, d.Support)
+	assert.Equal(t, map[string]bool{"sma_kof99_v2": false}, d.NewSlots)
+	assert.Equal(t, []AreaChange{{"kof98", "maincpu", 0x400000, 0x500000}}, d.Areas)
+	assert.Contains(t, d.Unresolved, UnresolvedReader{"kof99", "kof99", false})
+	assert.Contains(t, d.Unresolved, UnresolvedReader{"kof98", "kof98", true})
+	assert.NotContains(t, d.Unresolved, UnresolvedReader{"kof98", "kof98", false})
+}
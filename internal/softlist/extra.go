@@ -0,0 +1,288 @@
+package softlist
+
+// extraInformation augments the external MAME database with the
+// screenshot ID that is internal to the NeoSD firmware and provided by
+// `order.txt` found in the Neobuilder tool distribution. The genre codes
+// are internal to the same tool but don't have an external list.
+var extraInformation = map[string]struct {
+	screenshot int
+	genre      string
+}{
+	"alpham2":    {1, "Shooter"},
+	"alpham2p":   {1, "Shooter"},
+	"androdun":   {2, "Shooter"},
+	"aodk":       {3, "Fighting"},
+	"aof":        {4, "Fighting"},
+	"aof2":       {5, "Fighting"},
+	"aof2a":      {5, "Fighting"},
+	"aof3":       {6, "Fighting"},
+	"aof3k":      {6, "Fighting"},
+	"b2b":        {7, "Action"},
+	"bakatono":   {8, "Mahjong"},
+	"bangbead":   {9, "Sports"},
+	"bjourney":   {10, "Platformer"},
+	"blazstar":   {11, "Shooter"},
+	"breakers":   {12, "Fighting"},
+	"breakrev":   {13, "Fighting"},
+	"bstars":     {14, "Sports"},
+	"bstarsh":    {14, "Sports"},
+	"bstars2":    {15, "Sports"},
+	"burningf":   {16, "BeatEmUp"},
+	"burningfh":  {16, "BeatEmUp"},
+	"burningfp":  {16, "BeatEmUp"},
+	"burningfpa": {16, "BeatEmUp"},
+	"crswd2bl":   {17, "Action"},
+	"crsword":    {18, "Action"},
+	"ct2k3sp":    {19, "Fighting"},
+	"ct2k3sa":    {19, "Fighting"},
+	"cthd2003":   {20, "Fighting"},
+	"ctomaday":   {21, "Shooter"},
+	"cyberlip":   {22, "Action"},
+	"diggerma":   {23, "Puzzle"},
+	"doubledr":   {24, "Fighting"},
+	"dragonsh":   {25, "Fighting"},
+	"eightman":   {26, "Action"},
+	"fatfursp":   {27, "Fighting"},
+	"fatfurspa":  {27, "Fighting"},
+	"fatfury1":   {28, "Fighting"},
+	"fatfury2":   {29, "Fighting"},
+	"fatfury3":   {30, "Fighting"},
+	"fbfrenzy":   {31, "Sports"},
+	"fightfev":   {32, "Fighting"},
+	"fightfeva":  {32, "Fighting"},
+	"flipshot":   {33, "Sports"},
+	"froman2b":   {34, "Mahjong"},
+	"fswords":    {35, "Fighting"},
+	"galaxyfg":   {36, "Fighting"},
+	"ganryu":     {37, "Action"},
+	"garou":      {38, "Fighting"},
+	"garouh":     {38, "Fighting"},
+	"garouha":    {38, "Fighting"},
+	"garoup":     {38, "Fighting"},
+	"garoubl":    {38, "Fighting"},
+	"ghostlop":   {39, "Puzzle"},
+	"goalx3":     {40, "Sports"},
+	"gowcaizr":   {41, "Fighting"},
+	"gpilots":    {42, "Shooter"},
+	"gpilotsh":   {43, "Shooter"},
+	"gururin":    {44, "Puzzle"},
+	"ironclad":   {45, "Shooter"},
+	"ironclado":  {45, "Shooter"},
+	"irrmaze":    {46, "Other"}, // TODO
+	"janshin":    {47, "Mahjong"},
+	"jockeygp":   {48, "Sports"},
+	"jockeygpa":  {48, "Sports"},
+	"joyjoy":     {49, "Puzzle"},
+	"kabukikl":   {50, "Fighting"},
+	"karnovr":    {51, "Fighting"},
+	"kf10thep":   {52, "Fighting"},
+	"kf2k2mp":    {53, "Fighting"},
+	"kf2k2mp2":   {54, "Fighting"},
+	"kf2k2pla":   {55, "Fighting"},
+	"kf2k2pls":   {56, "Fighting"},
+	"kf2k3bl":    {57, "Fighting"},
+	"kf2k3bla":   {57, "Fighting"},
+	"kf2k3pl":    {58, "Fighting"},
+	"kf2k3upl":   {58, "Fighting"},
+	"kf2k5uni":   {59, "Fighting"},
+	"kizuna":     {60, "Fighting"},
+	"kof10th":    {62, "Fighting"}, // XXX Unsupported
+	"kof2000":    {63, "Fighting"},
+	"kof2000n":   {63, "Fighting"},
+	"kof2001":    {64, "Fighting"},
+	"kof2001h":   {64, "Fighting"},
+	"kof2002":    {65, "Fighting"},
+	"kof2002b":   {65, "Fighting"},
+	"kof2003":    {66, "Fighting"},
+	"kof2003h":   {66, "Fighting"},
+	"kf2k3pcb":   {66, "Fighting"}, // XXX Unsupported
+	"kof2k4se":   {67, "Fighting"},
+	"kof94":      {68, "Fighting"},
+	"kof95":      {69, "Fighting"},
+	"kof95a":     {69, "Fighting"},
+	"kof95h":     {69, "Fighting"},
+	"kof96":      {70, "Fighting"},
+	"kof96h":     {70, "Fighting"},
+	"kof97":      {71, "Fighting"},
+	"kof97h":     {71, "Fighting"},
+	"kof97k":     {71, "Fighting"},
+	"kof97oro":   {72, "Fighting"},
+	"kof97pls":   {73, "Fighting"},
+	"kof98":      {74, "Fighting"},
+	"kof98a":     {74, "Fighting"},
+	"kof98h":     {74, "Fighting"},
+	"kof98k":     {74, "Fighting"},
+	"kof98ka":    {74, "Fighting"},
+	"kof99":      {75, "Fighting"},
+	"kof99e":     {75, "Fighting"},
+	"kof99h":     {75, "Fighting"},
+	"kof99k":     {75, "Fighting"},
+	"kof99ka":    {75, "Fighting"}, // FIXME Not in order.txt
+	"kof99p":     {75, "Fighting"},
+	"kog":        {76, "Fighting"},
+	"kotm":       {77, "Fighting"},
+	"kotm2":      {78, "Fighting"},
+	"kotm2a":     {78, "Fighting"},
+	"kotm2p":     {78, "Fighting"},
+	"kotmh":      {79, "Fighting"},
+	"lans2004":   {80, "Action"},
+	"lastblad":   {81, "Fighting"},
+	"lastbladh":  {81, "Fighting"},
+	"lastbld2":   {82, "Fighting"},
+	"lastsold":   {84, "Fighting"},
+	"lbowling":   {85, "Sports"},
+	"legendos":   {86, "BeatEmUp"},
+	"lresort":    {87, "Shooter"},
+	"lresortp":   {87, "Shooter"},
+	"magdrop2":   {88, "Puzzle"},
+	"magdrop3":   {89, "Puzzle"},
+	"maglord":    {90, "Platformer"},
+	"maglordh":   {90, "Platformer"},
+	"mahretsu":   {91, "Mahjong"},
+	"marukodq":   {92, "Quiz"},
+	"matrim":     {93, "Fighting"},
+	"matrimbl":   {93, "Fighting"},
+	"miexchng":   {94, "Puzzle"},
+	"minasan":    {95, "Other"},
+	"moshougi":   {96, "Other"},
+	"ms4plus":    {97, "Action"},
+	"ms5plus":    {98, "Action"},
+	"mslug":      {99, "Action"},
+	"mslug2":     {100, "Action"},
+	"mslug2t":    {100, "Action"}, // TODO
+	"mslug3":     {101, "Action"},
+	"mslug3a":    {101, "Action"},
+	"mslug3h":    {101, "Action"},
+	"mslug3b6":   {102, "Action"},
+	"mslug4":     {103, "Action"},
+	"mslug4h":    {103, "Action"},
+	"mslug5":     {104, "Action"},
+	"mslug5h":    {104, "Action"},
+	"ms5pcb":     {104, "Action"}, // TODO
+	"mslugx":     {105, "Action"},
+	"mutnat":     {106, "BeatEmUp"},
+	"nam1975":    {107, "Action"},
+	"ncombat":    {108, "BeatEmUp"},
+	"ncombath":   {108, "BeatEmUp"},
+	"ncommand":   {109, "Shooter"},
+	"neobombe":   {110, "Puzzle"},
+	"neocup98":   {111, "Sports"},
+	"neodrift":   {112, "Driving"},
+	"neomrdo":    {113, "Action"},
+	"ninjamas":   {114, "Fighting"},
+	"nitd":       {115, "Action"},
+	"nitdbl":     {116, "Action"},
+	"overtop":    {117, "Driving"},
+	"panicbom":   {118, "Puzzle"},
+	"pbobbl2n":   {119, "Puzzle"},
+	"pbobblen":   {120, "Puzzle"},
+	"pbobblenb":  {120, "Puzzle"},
+	"pgoal":      {121, "Sports"},
+	"pnyaa":      {122, "Other"}, // TODO
+	"pnyaaa":     {122, "Other"}, // TODO
+	"popbounc":   {123, "Puzzle"},
+	"preisle2":   {124, "Shooter"},
+	"pspikes2":   {125, "Sports"},
+	"pulstar":    {126, "Shooter"},
+	"puzzldpr":   {127, "Puzzle"},
+	"puzzledp":   {127, "Puzzle"},
+	"quizdai2":   {128, "Quiz"},
+	"quizdais":   {129, "Quiz"},
+	"quizdaisk":  {129, "Quiz"},
+	"quizkof":    {130, "Quiz"},
+	"quizkofk":   {130, "Quiz"},
+	"ragnagrd":   {131, "Fighting"},
+	"rbff1":      {132, "Fighting"},
+	"rbff1a":     {132, "Fighting"},
+	"rbff1k":     {132, "Fighting"},
+	"rbff2":      {133, "Fighting"},
+	"rbff2h":     {133, "Fighting"},
+	"rbff2k":     {133, "Fighting"},
+	"rbffspec":   {134, "Fighting"},
+	"rbffspeck":  {134, "Fighting"},
+	"ridhero":    {135, "Driving"},
+	"ridheroh":   {135, "Driving"},
+	"roboarmy":   {136, "BeatEmUp"},
+	"rotd":       {137, "Fighting"},
+	"rotdh":      {137, "Fighting"},
+	"s1945p":     {138, "Shooter"},
+	"samsh5sp":   {139, "Fighting"},
+	"samsh5sph":  {139, "Fighting"},
+	"samsh5spho": {139, "Fighting"},
+	"samsho":     {140, "Fighting"},
+	"samshoh":    {140, "Fighting"},
+	"samsho2":    {141, "Fighting"},
+	"samsho2k":   {141, "Fighting"},
+	"samsho2ka":  {141, "Fighting"}, // FIXME Not in order.txt
+	"samsho3":    {142, "Fighting"},
+	"samsho3h":   {142, "Fighting"},
+	"samsho3k":   {142, "Fighting"},
+	"samsho4":    {143, "Fighting"},
+	"samsho4k":   {143, "Fighting"},
+	"samsho5":    {144, "Fighting"},
+	"samsho5a":   {144, "Fighting"},
+	"samsho5h":   {144, "Fighting"},
+	"samsho5b":   {144, "Fighting"},
+	"savagere":   {145, "Fighting"},
+	"sbp":        {146, "Puzzle"},
+	"sdodgeb":    {147, "Sports"},
+	"sengoku":    {148, "BeatEmUp"},
+	"sengokuh":   {148, "BeatEmUp"},
+	"sengoku2":   {149, "BeatEmUp"},
+	"sengoku3":   {150, "BeatEmUp"},
+	"shocktr2":   {151, "Action"},
+	"shocktro":   {152, "Action"}, // TODO
+	"shocktroa":  {152, "Action"},
+	"socbrawl":   {153, "Sports"},
+	"socbrawlh":  {153, "Sports"},
+	"sonicwi2":   {154, "Shooter"},
+	"sonicwi3":   {155, "Shooter"},
+	"spinmast":   {156, "Platformer"},
+	"ssideki":    {157, "Sports"},
+	"ssideki2":   {158, "Sports"},
+	"ssideki3":   {159, "Sports"},
+	"ssideki4":   {160, "Sports"},
+	"stakwin":    {161, "Sports"},
+	"stakwin2":   {162, "Sports"},
+	"strhoop":    {163, "Sports"},
+	"superspy":   {164, "BeatEmUp"},
+	"svc":        {165, "Fighting"},
+	"svcboot":    {165, "Fighting"},
+	"svcplus":    {166, "Fighting"},
+	"svcplusa":   {166, "Fighting"},
+	"svcsplus":   {167, "Fighting"},
+	"svcpcb":     {165, "Fighting"}, // TODO
+	"svcpcba":    {165, "Fighting"}, // TODO
+	"tophuntr":   {168, "Platformer"},
+	"tophuntrh":  {168, "Platformer"},
+	"tpgolf":     {169, "Sports"},
+	"trally":     {170, "Driving"},
+	"turfmast":   {171, "Sports"},
+	"twinspri":   {172, "Puzzle"},
+	"tws96":      {173, "Other"}, // TODO
+	"viewpoin":   {174, "Shooter"},
+	"vliner":     {175, "Other"}, // TODO
+	"vlinero":    {175, "Other"}, // TODO
+	"wakuwak7":   {176, "Fighting"},
+	"wh1":        {177, "Fighting"},
+	"wh1h":       {177, "Fighting"},
+	"wh1ha":      {177, "Fighting"},
+	"wh2":        {178, "Fighting"},
+	"wh2j":       {179, "Fighting"},
+	"whp":        {180, "Fighting"},
+	"wjammers":   {181, "Sports"},
+	"zedblade":   {182, "Shooter"},
+	"zintrckb":   {183, "Puzzle"},
+	"zupapa":     {184, "Action"},
+	"2020bb":     {185, "Sports"},
+	"2020bba":    {185, "Sports"},
+	"2020bbh":    {185, "Sports"},
+	"3countb":    {186, "Fighting"},
+}
+
+// nghNumbers is the NGH number of each game whose P ROM filenames don't
+// start with it, taken from its description or its other ROM images
+var nghNumbers = map[string]uint16{
+	"pbobblen": 0x083,
+	"pnyaa":    0x267,
+}
@@ -0,0 +1,392 @@
+// Package softlist reads the MAME software list for the Neo Geo
+package softlist
+
+import (
+	"encoding/xml"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	featureSlot  string = "slot"
+	loadFlagFill string = "fill"
+)
+
+// Areas lists the data areas in the same order as the areas of a .neo file
+var Areas = []string{"maincpu", "fixed", "audiocpu", "ymsnd", "ymsnd.deltat", "sprites"}
+
+// SoftwareLists is the root of a MAME software list XML file
+type SoftwareLists struct {
+	XMLName      xml.Name       `xml:"softwarelists"`
+	SoftwareList []SoftwareList `xml:"softwarelist"`
+}
+
+// SoftwareList is a list of games
+type SoftwareList struct {
+	XMLName  xml.Name   `xml:"softwarelist"`
+	Software []Software `xml:"software"`
+}
+
+// Read decodes a software list XML file
+func Read(r io.Reader) (*SoftwareLists, error) {
+	lists := new(SoftwareLists)
+	if err := xml.NewDecoder(r).Decode(lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// Software is a game
+type Software struct {
+	XMLName     xml.Name   `xml:"software"`
+	Name        string     `xml:"name,attr"`
+	CloneOf     string     `xml:"cloneof,attr"`
+	Supported   string     `xml:"supported,attr"`
+	Description string     `xml:"description"`
+	Year        uint32     `xml:"year"`
+	Publisher   string     `xml:"publisher"`
+	Feature     []Feature  `xml:"part>feature"`
+	DataArea    []DataArea `xml:"part>dataarea"`
+}
+
+// Genre returns the name of the genre constant for the game
+func (s Software) Genre() string {
+	if extra, ok := extraInformation[s.Name]; ok {
+		return extra.genre
+	}
+
+	return "Other"
+}
+
+// Reader returns the name of the function that decodes the game
+func (s Software) Reader() string {
+	if reader, ok := readers[s.Name]; ok {
+		return reader
+	}
+
+	return "common"
+}
+
+// FindDataArea returns the named data area or nil if the game doesn't have it
+func (s Software) FindDataArea(area string) *DataArea {
+	if area == "audiocpu" {
+		// Try and find an audiocrypt area first, both don't ever appear
+		if da := s.FindDataArea("audiocrypt"); da != nil {
+			return da
+		}
+	}
+	for _, da := range s.DataArea {
+		if da.Name == area {
+			return &da
+		}
+	}
+	return nil
+}
+
+// Screenshot returns the NeoSD screenshot number for the game
+func (s Software) Screenshot() int {
+	if extra, ok := extraInformation[s.Name]; ok {
+		return extra.screenshot
+	}
+
+	return 0
+}
+
+// IsSupportedSlot reports whether the cartridge protection or bootleg
+// hardware used by the game, if any, can be decoded
+func (s Software) IsSupportedSlot() bool {
+	for _, f := range s.Feature {
+		if f.Name == featureSlot {
+			switch f.Value {
+			case "boot_ct2k3sa", "boot_ct2k3sp", "boot_cthd2k3", "boot_garoubl", "boot_kf10th", "boot_kof97oro", "boot_lans2004", "boot_matrimbl", "boot_ms5plus", "boot_mslug3b6":
+				fallthrough
+			case "cmc42_bangbead", "cmc42_ganryu", "cmc42_kof99k", "cmc42_mslug3h", "cmc42_nitd", "cmc42_preisle2", "cmc42_s1945p", "cmc42_sengoku3", "cmc42_zupapa":
+				fallthrough
+			case "cmc50_kof2000n", "cmc50_kof2001", "cmc50_jockeygp":
+				fallthrough
+			case "k2k2_kf2k2p", "k2k2_kof2k2", "k2k2_matrim", "k2k2_sams5s", "k2k2_samsh5":
+				fallthrough
+			case "pcm2_ms4p", "pcm2_mslug4", "pcm2_pnyaa", "pcm2_rotd":
+				fallthrough
+			case "pvc_kf2k3", "pvc_kf2k3h", "pvc_mslug5", "pvc_svc":
+				fallthrough
+			case "rom_fatfur2", "rom_kof98", "rom_mslugx":
+				fallthrough
+			case "sma_garou", "sma_garouh", "sma_kof2k", "sma_kof99", "sma_mslug3", "sma_mslug3a":
+				return true
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Slot returns the cartridge slot type of the game, which is empty for a
+// standard cartridge
+func (s Software) Slot() string {
+	for _, f := range s.Feature {
+		if f.Name == featureSlot {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// IsSupported reports whether the generator includes the game
+func (s Software) IsSupported() bool {
+	return s.Supported != "no" && s.IsSupportedSlot()
+}
+
+// Readers returns the name of every reader used by a game the generator
+// includes in name order. The common reader is always included
+func (l *SoftwareLists) Readers() []string {
+	names := map[string]struct{}{
+		"common": {},
+	}
+	for _, list := range l.SoftwareList {
+		for _, s := range list.Software {
+			if s.IsSupported() {
+				names[s.Reader()] = struct{}{}
+			}
+		}
+	}
+
+	readers := make([]string, 0, len(names))
+	for name := range names {
+		readers = append(readers, name)
+	}
+	sort.Strings(readers)
+
+	return readers
+}
+
+// nghPattern matches the NGH number that starts the filenames of most ROM
+// images, such as 055-p1.p1 or 007_p1_faac.p1
+var nghPattern = regexp.MustCompile(`^(?:proto_)?([0-9]{3})[-._]`)
+
+// NGH returns the NGH number of the game, found from the filenames of its P
+// ROM images as the number in the description isn't always the same format
+func (s Software) NGH() (uint16, bool) {
+	if ngh, ok := nghNumbers[s.Name]; ok {
+		return ngh, true
+	}
+
+	da := s.FindDataArea(Areas[0])
+	if da == nil {
+		return 0, false
+	}
+
+	for _, r := range da.ROM {
+		if m := nghPattern.FindStringSubmatch(r.Name); m != nil {
+			ngh, err := strconv.ParseUint(m[1], 16, 16)
+			if err == nil {
+				return uint16(ngh), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// GamesByNGH returns the game the generator includes for each NGH number,
+// preferring parents and then the first by name. A clone without a number
+// in its filenames has the same number as its parent
+func (l *SoftwareLists) GamesByNGH() map[uint16]string {
+	games := make(map[string]Software)
+	for _, list := range l.SoftwareList {
+		for _, s := range list.Software {
+			if s.IsSupported() {
+				games[s.Name] = s
+			}
+		}
+	}
+
+	names := make([]string, 0, len(games))
+	for name := range games {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if pi, pj := games[names[i]].CloneOf == "", games[names[j]].CloneOf == ""; pi != pj {
+			return pi
+		}
+		return names[i] < names[j]
+	})
+
+	byNGH := make(map[uint16]string)
+	for _, name := range names {
+		s := games[name]
+		ngh, ok := s.NGH()
+		if !ok {
+			if ngh, ok = games[s.CloneOf].NGH(); !ok {
+				continue
+			}
+		}
+		if _, ok := byNGH[ngh]; !ok {
+			byNGH[ngh] = name
+		}
+	}
+
+	return byNGH
+}
+
+// Feature is a property of a game such as the cartridge slot type
+type Feature struct {
+	XMLName xml.Name `xml:"feature"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:"value,attr"`
+}
+
+// Size is a size that MAME writes in hex
+type Size uint64
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface
+func (v *Size) UnmarshalXMLAttr(attr xml.Attr) error {
+	i, err := strconv.ParseUint(attr.Value, 0, 64)
+	if err != nil {
+		return err
+	}
+	*v = Size(i)
+	return nil
+}
+
+// DataArea is the ROM images making up an area
+type DataArea struct {
+	XMLName xml.Name `xml:"dataarea"`
+	Name    string   `xml:"name,attr"`
+	Size    Size     `xml:"size,attr"`
+	ROM     []ROM    `xml:"rom"`
+}
+
+// IsEmpty reports whether the data area has no named ROM images
+func (d DataArea) IsEmpty() bool {
+	count := 0
+	for _, r := range d.ROM {
+		if r.Name != "" {
+			count++
+		}
+	}
+	return count == 0
+}
+
+// Fill returns the value MAME fills the data area with before loading it,
+// which is also what is left wherever a ROM image was never dumped
+func (d DataArea) Fill() uint8 {
+	for _, r := range d.ROM {
+		if r.LoadFlag == loadFlagFill {
+			return uint8(r.Value)
+		}
+	}
+	return 0
+}
+
+// ROM is a ROM image or, if it has no name, an instruction for loading the
+// ROM images such as filling the data area with a value
+type ROM struct {
+	XMLName  xml.Name `xml:"rom"`
+	Name     string   `xml:"name,attr"`
+	Size     Size     `xml:"size,attr"`
+	CRC      string   `xml:"crc,attr"`
+	Status   string   `xml:"status,attr"`
+	Value    Size     `xml:"value,attr"`
+	LoadFlag string   `xml:"loadflag,attr"`
+}
+
+// IsNodump reports whether the ROM image has never been dumped
+func (r ROM) IsNodump() bool {
+	return r.Status == "nodump"
+}
+
+// readers maps each game that can't be decoded with the common function to
+// the function that decodes it
+var readers = map[string]string{
+	"3countb":    "kotm2",
+	"alpham2p":   "kotm2p",
+	"aof":        "kotm2",
+	"bangbead":   "bangbead",
+	"burningfp":  "kotm2p",
+	"burningfpa": "kotm2p",
+	"ct2k3sa":    "ct2k3sa",
+	"ct2k3sp":    "ct2k3sp",
+	"cthd2003":   "cthd2003",
+	"dragonsh":   "dragonsh",
+	"fatfury2":   "kotm2",
+	"fightfeva":  "fightfeva",
+	"ganryu":     "ganryu",
+	"garou":      "garou",
+	"garoubl":    "garoubl",
+	"garouh":     "garouh",
+	"garouha":    "garou",
+	"gpilotsp":   "gpilotsp",
+	"kf2k2pls":   "kf2k2pls",
+	"kf2k2pla":   "kf2k2pls",
+	"kf2k3pcb":   "unsupported",
+	"kof10th":    "unsupported",
+	"kof2000":    "kof2000",
+	"kof2000n":   "kof2000n",
+	"kof2001":    "kof2001",
+	"kof2001h":   "kof2001",
+	"kof2002":    "kof2002",
+	"kof2003":    "kof2003",
+	"kof2003h":   "kof2003h",
+	"kof95a":     "kof95a",
+	"kof97oro":   "kof97oro",
+	"kof98":      "kof98",
+	"kof98a":     "kof98",
+	"kof98k":     "kof98",
+	"kof98ka":    "kof98",
+	"kof99":      "kof99",
+	"kof99e":     "kof99",
+	"kof99h":     "kof99",
+	"kof99k":     "kof99",
+	"kof99ka":    "kof99ka",
+	"kotm2":      "kotm2",
+	"kotm2a":     "kotm2",
+	"kotm2p":     "kotm2p",
+	"jockeygp":   "jockeygp",
+	"jockeygpa":  "jockeygp",
+	"lans2004":   "lans2004",
+	"lresortp":   "kotm2p",
+	"matrim":     "matrim",
+	"matrimbl":   "matrimbl",
+	"ms4plus":    "ms4plus",
+	"ms5plus":    "ms5plus",
+	"mslug3":     "mslug3",
+	"mslug3a":    "mslug3a",
+	"mslug3b6":   "mslug3b6",
+	"mslug3h":    "mslug3h",
+	"mslug4":     "mslug4",
+	"mslug4h":    "mslug4",
+	"mslug5":     "mslug5",
+	"mslug5h":    "mslug5",
+	"mslugx":     "kof95a",
+	"nitd":       "nitd",
+	"pbobblen":   "kof95a",
+	"pbobblenb":  "pbobblenb",
+	"pnyaa":      "pnyaa",
+	"pnyaaa":     "pnyaa",
+	"preisle2":   "preisle2",
+	"rotd":       "rotd",
+	"rotdh":      "rotd",
+	"s1945p":     "s1945p",
+	"samsh5sp":   "samsh5sp",
+	"samsh5sph":  "samsh5sp",
+	"samsh5spho": "samsh5sp",
+	"samsho3":    "kof95a",
+	"samsho5":    "samsho5",
+	"samsho5a":   "samsho5",
+	"samsho5h":   "samsho5",
+	"sengoku2":   "kotm2",
+	"sengoku3":   "sengoku3",
+	"sengoku3a":  "sengoku3",
+	"ssideki":    "viewpoin",
+	"svc":        "svc",
+	"viewpoin":   "viewpoin",
+	"viewpoinp":  "gpilotsp",
+	"wh1":        "kotm2",
+	"wh1h":       "kotm2",
+	"wh1ha":      "kotm2",
+	"zupapa":     "zupapa",
+}
@@ -0,0 +1,41 @@
+package softlist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGamesByNGH(t *testing.T) {
+	lists, err := Read(strings.NewReader(`<softwarelists><softwarelist name="neogeo">
+<software name="kof2001h" cloneof="kof2001"><description>The King of Fighters 2001 (NGH-2621)</description><part name="cart" interface="neo_cart">
+<dataarea name="maincpu" size="0x500000"><rom name="262-pg1.p1" size="0x100000" crc="2da8d8cf" /></dataarea>
+</part></software>
+<software name="kof2001"><part name="cart" interface="neo_cart"><feature name="slot" value="cmc50_kof2001" />
+<dataarea name="maincpu" size="0x500000"><rom name="262-p1-08-e0.p1" size="0x100000" crc="9381750d" /></dataarea>
+</part></software>
+<software name="kof2001hack" cloneof="kof2001"><part name="cart" interface="neo_cart">
+<dataarea name="maincpu" size="0x500000"><rom name="hack.p1" size="0x100000" crc="12345678" /></dataarea>
+</part></software>
+<software name="pbobblen"><part name="cart" interface="neo_cart">
+<dataarea name="maincpu" size="0x100000"><rom name="d96-07.ep1" size="0x80000" crc="6102ca14" /></dataarea>
+<dataarea name="sprites" size="0x100000"><rom name="068-c1.c1" size="0x100000" crc="7f250f76" /></dataarea>
+</part></software>
+<software name="alpham2p" cloneof="alpham2"><part name="cart" interface="neo_cart">
+<dataarea name="maincpu" size="0x200000"><rom name="007_p1_faac.p1" size="0x80000" crc="81e5e3f4" /></dataarea>
+</part></software>
+</softwarelist></softwarelists>`))
+	assert.Nil(t, err)
+
+	ngh, ok := lists.SoftwareList[0].Software[2].NGH()
+	assert.False(t, ok)
+	assert.Equal(t, uint16(0), ngh)
+
+	// Prefer the parent, which also wins over any number in a description
+	assert.Equal(t, map[uint16]string{
+		0x007: "alpham2p",
+		0x083: "pbobblen",
+		0x262: "kof2001",
+	}, lists.GamesByNGH())
+}
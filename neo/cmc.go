@@ -25,10 +25,14 @@ func (c cmc) decrypt(c0, c1 byte, table0hi, table0lo, table1 [256]byte, base int
 	return c0 ^ xor0, c1 ^ xor1
 }
 
-func (c cmc) gfxDecrypt(rom []byte, xor int) []byte {
+func (c cmc) gfxDecrypt(t *tracker, rom []byte, xor int) []byte {
 	tmp := make([]byte, len(rom))
+	total := uint64(len(rom)) * 2
 
 	for rpos := 0; rpos < len(rom)/4; rpos++ {
+		if rpos%progressInterval == 0 && !t.report(C, StageGfxDecrypt, uint64(rpos)*4, total) {
+			return tmp
+		}
 		tmp[4*rpos+0], tmp[4*rpos+3] = c.decrypt(rom[4*rpos+0], rom[4*rpos+3], c.type0T03, c.type0T12, c.type1T03, rpos, (rpos>>8)&1 > 0)
 		tmp[4*rpos+1], tmp[4*rpos+2] = c.decrypt(rom[4*rpos+1], rom[4*rpos+2], c.type0T12, c.type0T03, c.type1T12, rpos, (byte(rpos>>16)^c.address16to23Xor2[(rpos>>8)&0xff])&1 > 0)
 	}
@@ -36,6 +40,10 @@ func (c cmc) gfxDecrypt(rom []byte, xor int) []byte {
 	gfx := make([]byte, len(rom))
 
 	for rpos := 0; rpos < len(rom)/4; rpos++ {
+		if rpos%progressInterval == 0 && !t.report(C, StageGfxDecrypt, uint64(len(rom)+rpos*4), total) {
+			return gfx
+		}
+
 		baser := rpos
 		baser ^= xor
 		baser ^= int(c.address8to15Xor1[(baser>>16)&0xff]) << 8
@@ -63,10 +71,12 @@ func (c cmc) gfxDecrypt(rom []byte, xor int) []byte {
 		copy(gfx[4*rpos:4*rpos+4], tmp[4*baser:4*baser+4])
 	}
 
+	t.report(C, StageGfxDecrypt, total, total)
+
 	return gfx
 }
 
-func cmc42GfxDecrypt(rom []byte, xor int) []byte {
+func cmc42GfxDecrypt(t *tracker, rom []byte, xor int) []byte {
 	c := cmc{
 		type0T03: [256]byte{
 			0xfb, 0x86, 0x9d, 0xf1, 0xbf, 0x80, 0xd5, 0x43, 0xab, 0xb3, 0x9f, 0x6a, 0x33, 0xd9, 0xdb, 0xb6,
@@ -231,10 +241,10 @@ func cmc42GfxDecrypt(rom []byte, xor int) []byte {
 			0x48, 0xac, 0x7f, 0x3f, 0x95, 0xdc, 0x98, 0x9b, 0xbe, 0x23, 0x57, 0x3e, 0x5b, 0xd0, 0x3d, 0xa6,
 		},
 	}
-	return c.gfxDecrypt(rom, xor)
+	return c.gfxDecrypt(t, rom, xor)
 }
 
-func cmc50GfxDecrypt(rom []byte, xor int) []byte {
+func cmc50GfxDecrypt(t *tracker, rom []byte, xor int) []byte {
 	c := cmc{
 		type0T03: [256]byte{
 			0x10, 0x61, 0xf1, 0x78, 0x85, 0x52, 0x68, 0xe3, 0x12, 0x0d, 0xfa, 0xf0, 0xc9, 0x36, 0x5e, 0x3d,
@@ -399,15 +409,19 @@ func cmc50GfxDecrypt(rom []byte, xor int) []byte {
 			0x32, 0x3e, 0x45, 0xaf, 0x1e, 0x43, 0x44, 0x8c, 0x53, 0x86, 0x6b, 0xee, 0xa8, 0x8a, 0x8f, 0x17,
 		},
 	}
-	return c.gfxDecrypt(rom, xor)
+	return c.gfxDecrypt(t, rom, xor)
 }
 
-func cmcSfixDecrypt(gfx []byte, size int) []byte {
+func cmcSfixDecrypt(t *tracker, gfx []byte, size int) []byte {
 	sfix := make([]byte, size)
 	offset := len(gfx) - size
 	for i := 0; i < size; i++ {
+		if i%progressInterval == 0 && !t.report(S, StageSfixExtract, uint64(i), uint64(size)) {
+			return sfix
+		}
 		sfix[i] = gfx[offset+(i&^0x1f)+((i&7)<<2)+((^i&8)>>2)+((i&0x10)>>4)]
 	}
+	t.report(S, StageSfixExtract, uint64(size), uint64(size))
 	return sfix
 }
 
@@ -473,7 +487,12 @@ func m1AddressScramble(address int, key uint16) int {
 	return (block << 16) | aux
 }
 
-func cmc50M1Decrypt(rom []byte) []byte {
+func cmc50M1Decrypt(t *tracker, rom []byte) []byte {
+	// Too short to hold the key
+	if len(rom) < 0x10000 {
+		return rom
+	}
+
 	m1 := make([]byte, len(rom))
 
 	key := uint16(0)
@@ -482,10 +501,15 @@ func cmc50M1Decrypt(rom []byte) []byte {
 	}
 
 	for i := 0; i < len(rom); i++ {
+		if i%progressInterval == 0 && !t.report(M, StageM1Decrypt, uint64(i), uint64(len(rom))) {
+			return m1
+		}
 		if j := m1AddressScramble(i, key); j < len(rom) {
 			m1[i] = rom[j]
 		}
 	}
 
+	t.report(M, StageM1Decrypt, uint64(len(rom)), uint64(len(rom)))
+
 	return m1
 }
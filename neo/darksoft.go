@@ -0,0 +1,178 @@
+package neo
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// darksoftAreas maps the filenames used by the Darksoft multi-cart to each
+// area. The ROM images are already decrypted and the C ROM images are
+// already interleaved
+var darksoftAreas = map[string]int{
+	"prom":   P,
+	"prom1":  P,
+	"srom":   S,
+	"m1rom":  M,
+	"vroma0": V1,
+	"vromb0": V2,
+	"crom0":  C,
+}
+
+// isDarksoft reports whether the files in s use the Darksoft layout
+func isDarksoft(s *sourceIndex) bool {
+	found := make(map[string]bool)
+	for _, file := range s.files {
+		found[strings.ToLower(path.Base(file.name))] = true
+	}
+	return found["prom"] && found["crom0"]
+}
+
+// gameByNGH returns the game with the NGH number, preferring parents over
+// clones
+func gameByNGH(ngh uint16) (string, bool) {
+	name, ok := mameNGH[ngh]
+	if !ok {
+		return "", false
+	}
+	if _, ok := mameGames[name]; !ok {
+		return "", false
+	}
+	return name, true
+}
+
+func (f *File) readDarksoftROM(set romSet) error {
+	report := f.tracker.result
+	s, err := set.source()
+	if err != nil {
+		return err
+	}
+
+	sr := newSourceReaders(s)
+	defer sr.Close()
+
+	// Sorting keeps prom before prom1
+	files := make([]indexedFile, len(s.files))
+	copy(files, s.files)
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+
+	g := mameGame{}
+
+	readers := make([][]io.Reader, Areas)
+
+	for _, file := range files {
+		area, ok := darksoftAreas[strings.ToLower(path.Base(file.name))]
+		if !ok {
+			continue
+		}
+
+		g.area[area].size += file.size
+		g.area[area].rom = append(g.area[area].rom, mameROM{
+			filename: file.name,
+			size:     file.size,
+			crc:      file.crc,
+		})
+
+		reader, err := sr.open(0, file.name)
+		if err != nil {
+			return err
+		}
+		readers[area] = append(readers[area], reader)
+
+		report.Sources = append(report.Sources, ROMSource{
+			Area:     area,
+			Filename: file.name,
+			Source:   s.path,
+			File:     file.name,
+			Size:     file.size,
+			CRC:      file.crc,
+		})
+	}
+
+	report.Method, report.Reader = MethodDarksoft, MethodDarksoft
+
+	f.tracker.wrap(g, readers)
+
+	for i := 0; i < Areas; i++ {
+		if f.ROM[i], err = ioutil.ReadAll(io.MultiReader(readers[i]...)); err != nil {
+			return err
+		}
+	}
+
+	// Use the MAME metadata for the game if possible
+	name, ok := set.name(), false
+	if _, ok = mameGames[name]; !ok && len(f.ROM[P]) > offsetNGH+2 {
+		name, ok = gameByNGH(binary.LittleEndian.Uint16(f.ROM[P][offsetNGH:]))
+	}
+	if ok {
+		game := mameGames[name]
+		f.Year, f.Genre, f.Screenshot, f.Name, f.Manufacturer = game.year, game.genre, game.screenshot, game.name, game.manufacturer
+		report.Game, report.Parent = name, game.parent
+	}
+
+	return nil
+}
+
+// darksoftFPGA selects the standard cartridge mapping as the ROM images are
+// written already decrypted
+const darksoftFPGA = 0x00
+
+// darksoftImage is a file in the Darksoft layout
+type darksoftImage struct {
+	name string
+	b    []byte
+}
+
+// darksoftImages returns the ROM areas split into the files used by the
+// Darksoft layout. Any P ROM larger than 1 MB is split so the bankswitched
+// part is in prom1
+func (f *File) darksoftImages() []darksoftImage {
+	images := []darksoftImage{{"prom", f.ROM[P]}}
+	if len(f.ROM[P]) > oneMB {
+		images = []darksoftImage{{"prom", f.ROM[P][:oneMB]}, {"prom1", f.ROM[P][oneMB:]}}
+	}
+
+	return append(images, []darksoftImage{
+		{"srom", f.ROM[S]},
+		{"m1rom", f.ROM[M]},
+		{"vroma0", f.ROM[V1]},
+		{"vromb0", f.ROM[V2]},
+		{"crom0", f.ROM[C]},
+	}...)
+}
+
+func writeImages(dir string, images []darksoftImage) error {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		if err := ioutil.WriteFile(filepath.Join(dir, image.name), image.b, 0666); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportDarksoft writes the ROM areas to the directory dir using the layout
+// read by the Darksoft multi-cart, creating the directory if necessary. The
+// ROM images are written decrypted with the C ROM interleaved, exactly as
+// they are stored in f. There is no vromb0 for games without a second V ROM
+func (f *File) ExportDarksoft(dir string) error {
+	var images []darksoftImage
+	for _, image := range f.darksoftImages() {
+		if image.name != "vromb0" || len(image.b) > 0 {
+			images = append(images, image)
+		}
+	}
+
+	return writeImages(dir, append(images, darksoftImage{"fpga", []byte{darksoftFPGA}}))
+}
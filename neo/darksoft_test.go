@@ -0,0 +1,120 @@
+package neo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDarksoftROM(t *testing.T) {
+	f := testFile(0x200)
+	f.ROM[P] = append(make([]byte, oneMB), f.ROM[P]...)
+	f.ROM[P][offsetNGH], f.ROM[P][offsetNGH+1] = 0x05, 0x00
+
+	// A P ROM over 1MB is split in two
+	fsys := fstest.MapFS{
+		"prom":   &fstest.MapFile{Data: f.ROM[P][:oneMB]},
+		"prom1":  &fstest.MapFile{Data: f.ROM[P][oneMB:]},
+		"srom":   &fstest.MapFile{Data: f.ROM[S]},
+		"m1rom":  &fstest.MapFile{Data: f.ROM[M]},
+		"vroma0": &fstest.MapFile{Data: f.ROM[V1]},
+		"vromb0": &fstest.MapFile{Data: f.ROM[V2]},
+		"crom0":  &fstest.MapFile{Data: f.ROM[C]},
+		"fpga":   &fstest.MapFile{Data: []byte{0x00}},
+	}
+
+	tables := []struct {
+		name string
+		game string
+	}{
+		{"maglordh", "Magician Lord (NGH-005)"},
+		{"unknown", "Magician Lord (NGM-005)"},
+	}
+
+	for _, table := range tables {
+		n, err := NewFileFS(fsys, table.name)
+		if assert.Nil(t, err) {
+			assert.Equal(t, f.ROM, n.ROM)
+			assert.Equal(t, uint32(5), n.NGH)
+			assert.Equal(t, table.game, n.Name)
+		}
+	}
+}
+
+func TestExportDarksoft(t *testing.T) {
+	f := testFile(0x200)
+	f.ROM[P] = append(bytes.Repeat([]byte{0xaa}, oneMB), f.ROM[P]...)
+
+	dir := t.TempDir()
+	assert.Nil(t, f.ExportDarksoft(dir))
+
+	files := map[string][]byte{
+		"prom":   bytes.Repeat([]byte{0xaa}, oneMB),
+		"prom1":  f.ROM[P][oneMB:],
+		"srom":   f.ROM[S],
+		"m1rom":  f.ROM[M],
+		"vroma0": f.ROM[V1],
+		"vromb0": f.ROM[V2],
+		"crom0":  f.ROM[C],
+		"fpga":   {darksoftFPGA},
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, infos, len(files))
+
+	for name, b := range files {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if assert.Nil(t, err, name) {
+			assert.Equal(t, b, data, name)
+		}
+	}
+
+	n, err := NewFileFS(os.DirFS(dir), "unknown")
+	if assert.Nil(t, err) {
+		assert.Equal(t, f.ROM, n.ROM)
+	}
+
+	// Games with a single V ROM have no vromb0
+	f.ROM[V2] = nil
+	dir = t.TempDir()
+	assert.Nil(t, f.ExportDarksoft(dir))
+
+	infos, err = ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, infos, len(files)-1)
+
+	_, err = os.Stat(filepath.Join(dir, "vromb0"))
+	assert.True(t, os.IsNotExist(err))
+
+	n, err = NewFileFS(os.DirFS(dir), "unknown")
+	if assert.Nil(t, err) {
+		assert.Equal(t, f.ROM[V1], n.ROM[V1])
+		assert.Len(t, n.ROM[V2], 0)
+	}
+}
+
+func TestGameByNGH(t *testing.T) {
+	tables := []struct {
+		ngh  uint16
+		game string
+		ok   bool
+	}{
+		{0x005, "maglord", true},
+		{0x083, "pbobblen", true},
+		{0x242, "kof98", true},
+		{0x262, "kof2001", true},
+		{0x999, "", false},
+	}
+
+	for _, table := range tables {
+		game, ok := gameByNGH(table.ngh)
+		assert.Equal(t, table.ok, ok)
+		assert.Equal(t, table.game, game)
+	}
+}
@@ -0,0 +1,174 @@
+package neo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var errParentLoop = errors.New("neo: definitions have a parent loop")
+
+// mameEntry is an entry in the generated mameGames table
+type mameEntry struct {
+	mameGame
+	reader       gameReader
+	readerName   string
+	name         string
+	year         uint32
+	manufacturer string
+	genre        Genre
+	screenshot   uint32
+}
+
+// defineGame adds or replaces a game in the table of known games
+func defineGame(name string, game mameEntry) {
+	mameGames[name] = game
+
+	// Rebuild the checksum index the next time it's used
+	crcGamesOnce, crcGames = sync.Once{}, nil
+}
+
+// Definition describes a game to add to or replace in the games known to
+// MAME. Any metadata, reader or area left unset is taken from the parent
+type Definition struct {
+	Parent       string                    `json:"parent,omitempty"`
+	Name         string                    `json:"name,omitempty"`
+	Year         uint32                    `json:"year,omitempty"`
+	Manufacturer string                    `json:"manufacturer,omitempty"`
+	Genre        Genre                     `json:"genre,omitempty"`
+	Screenshot   uint32                    `json:"screenshot,omitempty"`
+	Reader       string                    `json:"reader,omitempty"`  // Name of a game reader or a Scheme such as cmc42 or sma:kof99
+	GfxKey       int                       `json:"gfx_key,omitempty"` // C ROM key when Reader is a Scheme
+	M1           bool                      `json:"m1,omitempty"`      // Whether to decrypt the M ROM when Reader is a Scheme
+	Areas        map[string]DefinitionArea `json:"areas"`             // Keyed by AreaName
+}
+
+// DefinitionArea lists the ROM images in an area. The size defaults to the
+// total size of the ROM images
+type DefinitionArea struct {
+	Size uint64          `json:"size,omitempty"`
+	Fill uint8           `json:"fill,omitempty"` // Used in place of any ROM image never dumped
+	ROMs []DefinitionROM `json:"roms"`
+}
+
+// DefinitionROM is a ROM image. A ROM image without a CRC was never dumped
+type DefinitionROM struct {
+	Filename string `json:"filename"`
+	Size     uint64 `json:"size"`
+	CRC      string `json:"crc,omitempty"`
+}
+
+// Define adds or replaces the game called name in the games known to MAME.
+// It must not be called while converting
+func Define(name string, d Definition) error {
+	game := mameEntry{
+		name:         d.Name,
+		year:         d.Year,
+		manufacturer: d.Manufacturer,
+		genre:        d.Genre,
+		screenshot:   d.Screenshot,
+		reader:       common,
+		readerName:   "common",
+	}
+	game.parent = d.Parent
+
+	if parent, ok := mameGames[d.Parent]; ok {
+		if game.name == "" {
+			game.name = parent.name
+		}
+		if game.year == 0 {
+			game.year = parent.year
+		}
+		if game.manufacturer == "" {
+			game.manufacturer = parent.manufacturer
+		}
+		if game.genre == Other {
+			game.genre = parent.genre
+		}
+		if game.screenshot == 0 {
+			game.screenshot = parent.screenshot
+		}
+		if d.Reader == "" {
+			game.reader, game.readerName = parent.reader, parent.readerName
+		}
+		game.area = parent.area
+	} else if d.Parent != "" {
+		return fmt.Errorf("neo: %s: unknown parent %s", name, d.Parent)
+	}
+
+	if d.Reader != "" {
+		if scheme, err := ParseScheme(d.Reader); err == nil {
+			scheme.GfxKey, scheme.M1 = d.GfxKey, d.M1
+			game.reader, game.readerName = scheme.read, scheme.String()
+		} else if r, ok := mameReaders[d.Reader]; ok {
+			game.reader, game.readerName = r, d.Reader
+		} else {
+			return fmt.Errorf("neo: %s: unknown reader %s", name, d.Reader)
+		}
+	}
+
+	for key, a := range d.Areas {
+		area := -1
+		for i := 0; i < Areas; i++ {
+			if AreaName(i) == key {
+				area = i
+			}
+		}
+		if area < 0 {
+			return fmt.Errorf("neo: %s: unknown area %s", name, key)
+		}
+
+		game.area[area] = mameArea{size: a.Size, fill: a.Fill}
+		for _, r := range a.ROMs {
+			rom := mameROM{filename: r.Filename, size: r.Size}
+			if r.CRC != "" {
+				crc, err := hex.DecodeString(r.CRC)
+				if err != nil || len(crc) != 4 {
+					return fmt.Errorf("neo: %s: invalid CRC %s for %s", name, r.CRC, r.Filename)
+				}
+				rom.crc = crc
+			}
+			game.area[area].rom = append(game.area[area].rom, rom)
+
+			if a.Size == 0 {
+				game.area[area].size += r.Size
+			}
+		}
+	}
+
+	defineGame(name, game)
+
+	return nil
+}
+
+// LoadDefinitions reads a JSON object of Definitions keyed by game name from
+// r and adds them to the games known to MAME. Parents are defined before
+// their clones. It must not be called while converting
+func LoadDefinitions(r io.Reader) error {
+	var definitions map[string]Definition
+	if err := json.NewDecoder(r).Decode(&definitions); err != nil {
+		return err
+	}
+
+	// Define each game once its parent is
+	for len(definitions) > 0 {
+		n := len(definitions)
+		for name, d := range definitions {
+			if _, ok := definitions[d.Parent]; ok && d.Parent != name {
+				continue
+			}
+			if err := Define(name, d); err != nil {
+				return err
+			}
+			delete(definitions, name)
+		}
+		if len(definitions) == n {
+			return errParentLoop
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package neo
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// copyGames replaces the table of known games with a copy for the test to
+// change and returns a function that puts the original back
+func copyGames() func() {
+	games, nghs := mameGames, mameNGH
+
+	mameGames = make(map[string]mameEntry)
+	for name, g := range games {
+		mameGames[name] = g
+	}
+	mameNGH = make(map[uint16]string)
+	for ngh, name := range nghs {
+		mameNGH[ngh] = name
+	}
+
+	return func() {
+		mameGames, mameNGH = games, nghs
+		crcGamesOnce, crcGames = sync.Once{}, nil
+	}
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	defer copyGames()()
+
+	definitions := `{
+		"kof99hb": {
+			"parent": "kof99ka",
+			"name": "The King of Fighters '99 (hack)",
+			"areas": {
+				"P": {"roms": [{"filename": "hb.p1", "size": 1048576, "crc": "0a1b2c3d"}]},
+				"C": {"size": 33554432, "roms": [{"filename": "hb.c1", "size": 8388608}]}
+			}
+		},
+		"kof99hb2": {
+			"parent": "kof99hb",
+			"reader": "cmc50",
+			"gfx_key": 17,
+			"areas": {}
+		}
+	}`
+
+	assert.Nil(t, LoadDefinitions(strings.NewReader(definitions)))
+
+	g := mameGames["kof99hb"]
+	assert.Equal(t, "The King of Fighters '99 (hack)", g.name)
+	assert.Equal(t, mameGames["kof99ka"].year, g.year)
+	assert.Equal(t, uint64(1048576), g.area[P].size)
+	assert.Equal(t, []byte{0x0a, 0x1b, 0x2c, 0x3d}, g.area[P].rom[0].crc)
+	assert.Equal(t, uint64(33554432), g.area[C].size)
+	assert.True(t, g.area[C].rom[0].nodump())
+	assert.Equal(t, "kof99ka", mameGames["kof99hb"].readerName)
+	assert.ElementsMatch(t, []string{"kof99hb", "kof99hb2"}, gamesByCRC()[string([]byte{0x0a, 0x1b, 0x2c, 0x3d})])
+
+	assert.Equal(t, "The King of Fighters '99 (hack)", mameGames["kof99hb2"].name)
+	assert.Equal(t, "cmc50", mameGames["kof99hb2"].readerName)
+	assert.Equal(t, g.area, mameGames["kof99hb2"].area)
+
+	// Only the areas that are listed replace those of the parent
+	assert.Nil(t, LoadDefinitions(strings.NewReader(`{"kof99hb3": {"parent": "kof99hb", "areas": {"S": {"roms": [{"filename": "hb3.s1", "size": 131072}]}}}}`)))
+	g3 := mameGames["kof99hb3"]
+	assert.Equal(t, "hb3.s1", g3.area[S].rom[0].filename)
+	assert.Equal(t, g.area[P], g3.area[P])
+	assert.Equal(t, g.area[C], g3.area[C])
+
+	assert.NotNil(t, LoadDefinitions(strings.NewReader(`{"x": {"reader": "nonsense"}}`)))
+	assert.NotNil(t, LoadDefinitions(strings.NewReader(`{"x": {"parent": "nonsense"}}`)))
+	assert.Equal(t, errParentLoop, LoadDefinitions(strings.NewReader(`{"x": {"parent": "y"}, "y": {"parent": "x"}}`)))
+}
@@ -0,0 +1,151 @@
+package neo
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"regexp"
+)
+
+var errNotCommon = errors.New("neo: game is encrypted, rearranged or patched so its MAME ROM images cannot be recreated")
+
+// section returns size bytes of b starting at offset, padding with zeroes
+// if b is too short
+func section(b []byte, offset, size uint64) []byte {
+	s := make([]byte, size)
+	if offset < uint64(len(b)) {
+		copy(s, b[offset:])
+	}
+	return s
+}
+
+// commonPSplit is the inverse of commonPReader for an area without patch
+// ROMs, the bytes they overlay can't be recovered
+func commonPSplit(a mameArea, b []byte) [][]byte {
+	roms := make([][]byte, len(a.rom))
+
+	var offset uint64
+	for i, r := range a.rom {
+		roms[i] = section(b, offset, r.size)
+		offset += r.size
+
+		if i == 0 && r.size == twoMB {
+			roms[i] = append(roms[i][oneMB:], roms[i][:oneMB]...)
+		}
+	}
+
+	return roms
+}
+
+// commonCSplit is the inverse of commonCReader
+func commonCSplit(a mameArea, b []byte) [][]byte {
+	roms := make([][]byte, len(a.rom))
+
+	for i := 0; i < len(a.rom); i += 2 {
+		offset := uint64(i) * a.padSize()
+		for j := 0; j < 2 && i+j < len(a.rom); j++ {
+			roms[i+j] = make([]byte, a.rom[i+j].size)
+			for k := range roms[i+j] {
+				if x := offset + uint64(k*2+j); x < uint64(len(b)) {
+					roms[i+j][k] = b[x]
+				}
+			}
+		}
+	}
+
+	return roms
+}
+
+// commonPaddedSplit is the inverse of commonPaddedReader
+func commonPaddedSplit(a mameArea, b []byte) [][]byte {
+	roms := make([][]byte, len(a.rom))
+
+	for i, r := range a.rom {
+		roms[i] = section(b, uint64(i)*a.padSize(), r.size)
+	}
+
+	return roms
+}
+
+// genericGame describes the ROM images in a form that readGenericROM can
+// read back in
+func (f *File) genericGame() mameGame {
+	filenames := [Areas][]string{
+		{"p1.p1"},
+		{"s1.s1"},
+		{"m1.m1"},
+		{"v11.v11"},
+		{"v21.v21"},
+		{"c1.c1", "c2.c2"},
+	}
+
+	g := mameGame{}
+
+	for i := 0; i < Areas; i++ {
+		if len(f.ROM[i]) == 0 {
+			continue
+		}
+
+		g.area[i].size = uint64(len(f.ROM[i]))
+		for _, filename := range filenames[i] {
+			g.area[i].rom = append(g.area[i].rom, mameROM{
+				filename: filename,
+				size:     uint64(len(f.ROM[i]) / len(filenames[i])),
+			})
+		}
+	}
+
+	return g
+}
+
+// ExportMAME writes the ROM areas to w as a zip archive using the same
+// filenames, sizes and padding as the MAME ROM set for the named game. If
+// the game is not known to MAME then a generic set of filenames is used
+// that NewFile can read back in.
+//
+// The ROM images are written using the common layout so games that MAME
+// decrypts or rearranges when loading are refused as the result wouldn't be
+// the MAME ROM set. So are games with a patch ROM as it overwrites the start
+// of the P ROM
+func (f *File) ExportMAME(w io.Writer, name string) error {
+	g := f.genericGame()
+	if game, ok := mameGames[name]; ok {
+		if game.readerName != "common" {
+			return errNotCommon
+		}
+		re := regexp.MustCompile(`\.ep`)
+		for _, r := range game.area[P].rom {
+			if re.MatchString(r.filename) {
+				return errNotCommon
+			}
+		}
+		g = game.mameGame
+	}
+
+	zw := zip.NewWriter(w)
+
+	for i := 0; i < Areas; i++ {
+		var roms [][]byte
+		switch i {
+		case P:
+			roms = commonPSplit(g.area[P], f.ROM[P])
+		case C:
+			roms = commonCSplit(g.area[C], f.ROM[C])
+		default:
+			roms = commonPaddedSplit(g.area[i], f.ROM[i])
+		}
+
+		for j, r := range g.area[i].rom {
+			fw, err := zw.Create(r.filename)
+			if err != nil {
+				return err
+			}
+
+			if _, err := fw.Write(roms[j]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,101 @@
+package neo
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testFile returns a File with a distinct byte pattern in each area, area i
+// being size*(i+1) bytes
+func testFile(size int) *File {
+	f := new(File)
+	for i := 0; i < Areas; i++ {
+		f.ROM[i] = make([]byte, size*(i+1))
+		for j := range f.ROM[i] {
+			f.ROM[i][j] = byte(i + j)
+		}
+	}
+	return f
+}
+
+// exportMAME returns the contents of each file in the zip archive written
+// by ExportMAME
+func exportMAME(t *testing.T, f *File, name string) (*zip.Reader, map[string][]byte) {
+	b := new(bytes.Buffer)
+	assert.Nil(t, f.ExportMAME(b, name))
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	assert.Nil(t, err)
+
+	files := make(map[string][]byte)
+	for _, zf := range zr.File {
+		rc, err := zf.Open()
+		assert.Nil(t, err)
+		files[zf.Name], err = ioutil.ReadAll(rc)
+		assert.Nil(t, err)
+		rc.Close()
+	}
+
+	return zr, files
+}
+
+func TestExportMAME(t *testing.T) {
+	f := testFile(0x40)
+	f.ROM[P] = make([]byte, twoMB)
+	f.ROM[P][0] = 0xaa
+
+	_, files := exportMAME(t, f, "not a game")
+
+	g := f.genericGame()
+	readers := make([][]io.Reader, Areas)
+	for i := 0; i < Areas; i++ {
+		for _, r := range g.area[i].rom {
+			readers[i] = append(readers[i], bytes.NewReader(files[r.filename]))
+		}
+	}
+
+	n := new(File)
+	assert.Nil(t, common(n, g, readers))
+	assert.Equal(t, f.ROM, n.ROM)
+}
+
+func TestExportMAMELayout(t *testing.T) {
+	f := new(File)
+	f.ROM[P] = append(bytes.Repeat([]byte{1}, oneMB), bytes.Repeat([]byte{2}, oneMB)...)
+	f.ROM[S] = bytes.Repeat([]byte{3}, 2*oneTwentyEightKB)
+	f.ROM[V1] = make([]byte, 3*twoMB)
+	for i := range f.ROM[V1] {
+		f.ROM[V1][i] = byte(i/twoMB + 1)
+	}
+	// Each pair of C ROM images is interleaved a word at a time
+	f.ROM[C] = make([]byte, 8*twoMB)
+	for i := range f.ROM[C] {
+		f.ROM[C][i] = byte(i/(2*twoMB)*2 + i%2 + 1)
+	}
+
+	_, files := exportMAME(t, f, "kof94")
+	assert.Len(t, files, 14)
+
+	// The two halves of a 2MB P ROM image are swapped
+	assert.Equal(t, append(bytes.Repeat([]byte{2}, oneMB), bytes.Repeat([]byte{1}, oneMB)...), files["055-p1.p1"])
+	assert.Equal(t, bytes.Repeat([]byte{3}, oneTwentyEightKB), files["055-s1.s1"])
+	assert.Equal(t, make([]byte, oneTwentyEightKB), files["055-m1.m1"])
+	for i := 1; i <= 3; i++ {
+		assert.Equal(t, bytes.Repeat([]byte{byte(i)}, twoMB), files[fmt.Sprintf("055-v%d.v%d", i, i)])
+	}
+	for i := 1; i <= 8; i++ {
+		assert.Equal(t, bytes.Repeat([]byte{byte(i)}, twoMB), files[fmt.Sprintf("055-c%d.c%d", i, i)])
+	}
+
+	// An encrypted game can't be recreated, nor can one with a patch ROM
+	// overwriting the start of the P ROM
+	assert.Equal(t, errNotCommon, f.ExportMAME(ioutil.Discard, "kof99"))
+	assert.Equal(t, "common", mameGames["aof2a"].readerName)
+	assert.Equal(t, errNotCommon, f.ExportMAME(ioutil.Discard, "aof2a"))
+}
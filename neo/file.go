@@ -5,18 +5,17 @@ package neo
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
-
-	"github.com/bodgit/rom"
 )
 
 const (
@@ -35,14 +34,37 @@ const (
 	Areas
 )
 
+// AreaName returns the conventional name of a ROM area such as "P" or "V1"
+func AreaName(area int) string {
+	switch area {
+	case P:
+		return "P"
+	case S:
+		return "S"
+	case M:
+		return "M"
+	case V1:
+		return "V1"
+	case V2:
+		return "V2"
+	case C:
+		return "C"
+	default:
+		return strconv.Itoa(area)
+	}
+}
+
 var (
 	errInvalid      = errors.New("neo: invalid data")
 	errTooMuch      = errors.New("neo: too much data")
 	errGameNotFound = errors.New("neo: game not found")
-	errROMNotFound  = errors.New("neo: ROM not found")
-	errUnsupported  = errors.New("neo: unsupported game")
+	errSizeMismatch = errors.New("neo: ROM sizes do not match")
 )
 
+// ErrUnsupported is returned for games known to MAME that cannot be
+// converted
+var ErrUnsupported = errors.New("neo: unsupported game")
+
 var signature = [3]byte{'N', 'E', 'O'}
 
 type fileHeader struct {
@@ -50,8 +72,9 @@ type fileHeader struct {
 	Version   byte
 }
 
+// Newer versions are assumed to only add fields in the reserved area
 func (h fileHeader) isValid() bool {
-	return bytes.Equal(h.Signature[:], signature[:]) && h.Version == 1
+	return bytes.Equal(h.Signature[:], signature[:]) && h.Version >= 1
 }
 
 func newFileHeader(version byte) fileHeader {
@@ -67,7 +90,7 @@ const (
 	offsetNGH          int = 0x108
 )
 
-type fileFields struct {
+type headerFields struct {
 	fileHeader
 	Size         [Areas]uint32
 	Year         uint32
@@ -76,10 +99,77 @@ type fileFields struct {
 	NGH          uint32
 	Name         [nameLength]byte
 	Manufacturer [manufacturerLength]byte
-	_            [4002]byte
 }
 
-// File represents a .neo file. It is simply a header followed by six ROM sections
+func (hf headerFields) header() Header {
+	h := Header{
+		Year:         hf.Year,
+		Genre:        Genre(hf.Genre),
+		Screenshot:   hf.Screenshot,
+		NGH:          hf.NGH,
+		Name:         strings.TrimRight(string(hf.Name[:]), "\x00"),
+		Manufacturer: strings.TrimRight(string(hf.Manufacturer[:]), "\x00"),
+	}
+	copy(h.Size[:], hf.Size[:])
+	return h
+}
+
+func (hf *headerFields) setHeader(h Header) {
+	copy(hf.Size[:], h.Size[:])
+
+	hf.Year = h.Year
+	hf.Genre = uint32(h.Genre)
+	hf.Screenshot = h.Screenshot
+	hf.NGH = h.NGH
+
+	hf.Name = [nameLength]byte{}
+	copy(hf.Name[:], h.Name)
+	hf.Manufacturer = [manufacturerLength]byte{}
+	copy(hf.Manufacturer[:], h.Manufacturer)
+}
+
+const reservedLength int = 4002
+
+type fileFields struct {
+	headerFields
+	Reserved [reservedLength]byte
+}
+
+// NonZeroReserved returns the offsets within the header of any reserved
+// bytes that are not zero, which suggests the file was created by another
+// tool or with a newer version of the format
+func (ff fileFields) NonZeroReserved() []int {
+	var offsets []int
+	start := headerSize - reservedLength
+	for i, b := range ff.Reserved {
+		if b != 0 {
+			offsets = append(offsets, start+i)
+		}
+	}
+	return offsets
+}
+
+// headerSize is the size of the encoded fileFields structure, the ROM
+// areas immediately follow it
+const headerSize = 4096
+
+func readFileFields(r io.Reader) (fileFields, error) {
+	var ff fileFields
+	if err := binary.Read(r, binary.LittleEndian, &ff); err != nil {
+		return ff, err
+	}
+
+	if !ff.isValid() {
+		return ff, errInvalid
+	}
+
+	return ff, nil
+}
+
+// File represents a .neo file. It is simply a header followed by six ROM
+// sections. The header version and reserved bytes of a decoded file are
+// kept and written back out unchanged when it is encoded again, a new File
+// is written as version 1 unless Version is set
 type File struct {
 	fileFields
 	Size         [Areas]uint32
@@ -90,28 +180,55 @@ type File struct {
 	Name         string
 	Manufacturer string
 	ROM          [Areas][]byte
+	tracker      *tracker
 }
 
 // NewFile returns a File based on the passed zip file or directory
 // containing Neo Geo ROM images. If the last element of the path stripped
 // of any .extension matches a game known to MAME then it will use MAME
 // logic to decode the ROM images otherwise it falls back to generic logic
-// based solely on the filenames
+// based solely on the filenames. A clone doesn't need its own zip file or
+// directory if its ROM images are in a merged set belonging to the parent
 func NewFile(path string) (*File, error) {
-	f := new(File)
+	return NewIndex().NewFile(path)
+}
 
-	path = filepath.Clean(path)
+// NewFile is like the package-level NewFile but reuses any checksums
+// already computed by the Index
+func (idx *Index) NewFile(path string) (*File, error) {
+	f, _, err := idx.Convert(context.Background(), path, nil)
+	return f, err
+}
 
-	if err := f.readMameROM(path); err != nil {
-		if err != errGameNotFound {
-			return nil, err
-		}
+// Convert is like NewFile but stops early if ctx is cancelled, reports
+// progress as set in opts, which may be nil, and also returns a report of
+// how the File was created
+func Convert(ctx context.Context, path string, opts *Options) (*File, *ConversionReport, error) {
+	return NewIndex().Convert(ctx, path, opts)
+}
 
-		log.Println("Not found in MAME, attempting to guess")
+// Convert is like the package-level Convert but reuses any checksums already
+// computed by the Index
+func (idx *Index) Convert(ctx context.Context, path string, opts *Options) (*File, *ConversionReport, error) {
+	return newFile(ctx, &pathSet{idx, filepath.Clean(path)}, opts)
+}
 
-		if err := f.readGenericROM(path); err != nil {
-			return nil, err
-		}
+func newFile(ctx context.Context, set romSet, opts *Options) (*File, *ConversionReport, error) {
+	f := &File{
+		tracker: newTracker(ctx, opts),
+	}
+	report := f.tracker.result
+	defer func() {
+		f.tracker = nil
+	}()
+
+	if err := f.read(set); err != nil {
+		return nil, nil, err
+	}
+
+	// Decoding gives up early without an error if cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
 	}
 
 	// Update the sizes if the read was successful
@@ -123,149 +240,261 @@ func NewFile(path string) (*File, error) {
 		f.NGH = uint32(binary.LittleEndian.Uint16(f.ROM[P][offsetNGH:]))
 	}
 
-	return f, nil
+	return f, report, nil
+}
+
+// Header returns the metadata of the file
+func (f *File) Header() Header {
+	return Header{
+		Size:         f.Size,
+		Year:         f.Year,
+		Genre:        f.Genre,
+		Screenshot:   f.Screenshot,
+		NGH:          f.NGH,
+		Name:         f.Name,
+		Manufacturer: f.Manufacturer,
+	}
 }
 
 // MarshalBinary encodes the file into binary form and returns the result
 func (f *File) MarshalBinary() ([]byte, error) {
-	f.fileFields = fileFields{}
-	f.fileFields.fileHeader = newFileHeader(1)
+	w := new(bytes.Buffer)
+	// Writes to bytes.Buffer never error
+	_, _ = f.WriteTo(w)
 
-	copy(f.fileFields.Size[:], f.Size[:])
+	return w.Bytes(), nil
+}
 
-	f.fileFields.Year = f.Year
-	f.fileFields.Genre = uint32(f.Genre)
-	f.fileFields.Screenshot = f.Screenshot
-	f.fileFields.NGH = f.NGH
+// UnmarshalBinary decodes the file from binary form
+func (f *File) UnmarshalBinary(b []byte) error {
+	_, err := f.ReadFrom(bytes.NewReader(b))
+	return err
+}
 
-	copy(f.fileFields.Name[:], f.Name)
-	copy(f.fileFields.Manufacturer[:], f.Manufacturer)
+// WriteTo writes the file in binary form to w. It implements the
+// io.WriterTo interface
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	if !f.isValid() {
+		version := f.Version
+		if version == 0 {
+			version = 1
+		}
+		f.fileFields.fileHeader = newFileHeader(version)
+	}
+	f.fileFields.setHeader(f.Header())
 
-	w := new(bytes.Buffer)
+	b := new(bytes.Buffer)
 	// Writes to bytes.Buffer never error
-	_ = binary.Write(w, binary.LittleEndian, &f.fileFields)
+	_ = binary.Write(b, binary.LittleEndian, &f.fileFields)
+
+	n, err := w.Write(b.Bytes())
+	if err != nil {
+		return int64(n), err
+	}
+	written := int64(n)
 
 	for i := 0; i < Areas; i++ {
-		_, _ = w.Write(f.ROM[i])
+		n, err := w.Write(f.ROM[i])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
 	}
 
-	return w.Bytes(), nil
+	return written, nil
 }
 
-// UnmarshalBinary decodes the file from binary form
-func (f *File) UnmarshalBinary(b []byte) error {
-	r := bytes.NewReader(b)
-	if err := binary.Read(r, binary.LittleEndian, &f.fileFields); err != nil {
-		return err
-	}
+// ReadFrom reads the file in binary form from r until EOF. It implements
+// the io.ReaderFrom interface
+func (f *File) ReadFrom(r io.Reader) (int64, error) {
+	var read int64
 
-	if !f.isValid() {
-		return errInvalid
+	b := make([]byte, headerSize)
+	n, err := io.ReadFull(r, b)
+	read += int64(n)
+	if err != nil {
+		return read, err
 	}
 
-	copy(f.Size[:], f.fileFields.Size[:])
-
-	f.Year = f.fileFields.Year
-	f.Genre = Genre(f.fileFields.Genre)
-	f.Screenshot = f.fileFields.Screenshot
-	f.NGH = f.fileFields.NGH
+	if f.fileFields, err = readFileFields(bytes.NewReader(b)); err != nil {
+		return read, err
+	}
 
-	f.Name = strings.TrimRight(string(f.fileFields.Name[:]), "\x00")
-	f.Manufacturer = strings.TrimRight(string(f.fileFields.Manufacturer[:]), "\x00")
+	h := f.fileFields.header()
+	f.Size, f.Year, f.Genre, f.Screenshot, f.NGH, f.Name, f.Manufacturer = h.Size, h.Year, h.Genre, h.Screenshot, h.NGH, h.Name, h.Manufacturer
 
 	for i := 0; i < Areas; i++ {
+		f.ROM[i] = nil
 		if f.Size[i] > 0 {
 			f.ROM[i] = make([]byte, f.Size[i])
-			if _, err := r.Read(f.ROM[i]); err != nil {
-				return err
+			n, err := io.ReadFull(r, f.ROM[i])
+			read += int64(n)
+			if err != nil {
+				return read, err
 			}
 		}
 	}
 
 	// There should be no more data to read
 	if n, _ := io.CopyN(ioutil.Discard, r, 1); n != 0 {
-		return errTooMuch
+		return read + n, errTooMuch
+	}
+
+	return read, nil
+}
+
+func (f *File) read(set romSet) error {
+	// Already decrypted sets are recognised by their filenames
+	if s, err := set.source(); err == nil && isDarksoft(s) {
+		return f.readDarksoftROM(set)
+	}
+
+	if err := f.readMameROM(set); err != errGameNotFound {
+		return err
 	}
 
-	return nil
+	f.tracker.result.warn("%s not found in MAME, guessing from the filenames", set.name())
+
+	return f.readGenericROM(set)
 }
 
-func (f *File) readMameROM(path string) error {
-	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+func (f *File) readMameROM(set romSet) error {
+	report := f.tracker.result
+	base := set.name()
 
 	g, ok := mameGames[base]
 	if !ok {
-		return errGameNotFound
+		s, err := set.source()
+		if err != nil {
+			return err
+		}
+
+		// Try and work out the game from the ROM images instead
+		if base, ok = identify(s); !ok {
+			return errGameNotFound
+		}
+
+		report.warn("%s identified as %s by checksum", set.name(), base)
+
+		g = mameGames[base]
 	}
 
+	report.Method, report.Game, report.Parent, report.Reader = MethodMAME, base, g.parent, mameGames[base].readerName
+
 	f.Year, f.Genre, f.Screenshot, f.Name, f.Manufacturer = g.year, g.genre, g.screenshot, g.name, g.manufacturer
 
-	r, err := rom.NewReader(path)
+	sources, err := set.sources(base)
 	if err != nil {
 		return err
 	}
-	defer r.Close()
-	rr := []rom.Reader{r}
 
-	// Assume there's never a grandparent ROM
-	if g.parent != "" {
-		r, err := rom.NewReader(filepath.Join(filepath.Dir(path), g.parent+filepath.Ext(path)))
-		if err != nil {
-			return err
-		}
-		defer r.Close()
-		rr = append(rr, r)
-	}
+	sr := newSourceReaders(sources...)
+	defer sr.Close()
 
 	readers := make([][]io.Reader, Areas)
+	missing := &MissingROMError{Game: base}
+
+	matches := matchROMs(g.mameGame, sources, f.tracker.opts.AllowMismatch)
 
 	for i := 0; i < Areas; i++ {
-		for _, mr := range g.area[i].rom {
-			var reader io.ReadCloser
-		reader:
-			for _, r := range rr {
-				for _, file := range r.Files() {
-					crc, err := r.Checksum(file, rom.CRC32)
+		for j, mr := range g.area[i].rom {
+			m := matches[i][j]
+			if m == nil && mr.nodump() {
+				// Use a file named in the options or with the same
+				// name in the ROM set if one was supplied
+				if path, ok := f.tracker.opts.Nodump[mr.filename]; ok {
+					reader, size, err := sr.openFile(path)
 					if err != nil {
 						return err
 					}
-					if bytes.Equal(crc, mr.crc) {
-						reader, err = r.Open(file)
-						if err != nil {
-							return err
-						}
-						defer reader.Close()
-						break reader
+					if size != mr.size {
+						return fmt.Errorf("neo: %s is %d bytes, %s should be %d", path, size, mr.filename, mr.size)
 					}
+					readers[i] = append(readers[i], reader)
+
+					report.Sources = append(report.Sources, ROMSource{
+						Area:     i,
+						Filename: mr.filename,
+						Source:   filepath.Dir(path),
+						File:     filepath.Base(path),
+						Size:     size,
+						Mismatch: true,
+					})
+					report.Unverified = true
+					report.warn("%s used for %s which has never been dumped", path, mr.filename)
+					continue
+				}
+				if k, file, ok := findFilename(sources, mr.filename); ok {
+					m = &romMatch{source: k, file: file, by: "filename"}
+				} else {
+					fill := g.area[i].fill
+					report.placeholder(i)
+					report.warn("%s has never been dumped, filled with %d bytes of 0x%02x", mr.filename, mr.size, fill)
+					readers[i] = append(readers[i], bytes.NewReader(bytes.Repeat([]byte{fill}, int(mr.size))))
+					continue
 				}
 			}
-			if reader == nil {
-				return errROMNotFound
+			if m == nil {
+				owner := romOwner(base, mr.crc)
+				missing.ROMs = append(missing.ROMs, MissingROM{
+					Game:     owner,
+					Parent:   owner != base,
+					Area:     i,
+					Filename: mr.filename,
+					Size:     mr.size,
+					CRC:      mr.crc,
+				})
+				continue
+			}
+
+			reader, err := sr.open(m.source, m.file.name)
+			if err != nil {
+				return err
 			}
 			readers[i] = append(readers[i], reader)
+
+			report.Sources = append(report.Sources, ROMSource{
+				Area:     i,
+				Filename: mr.filename,
+				Source:   sources[m.source].path,
+				File:     m.file.name,
+				Size:     m.file.size,
+				CRC:      m.file.crc,
+				Mismatch: m.by != "",
+			})
+
+			switch {
+			case mr.nodump():
+				report.Unverified = true
+				report.warn("%s used for %s which has never been dumped", m.file.name, mr.filename)
+			case m.by != "":
+				report.Unverified = true
+				report.warn("%s with CRC %s substituted for %s with CRC %s by %s", m.file.name, Checksum(m.file.crc), mr.filename, Checksum(mr.crc), m.by)
+			}
 		}
 	}
 
-	return g.reader(f, g.mameGame, readers)
-}
-
-type byROMFilename []string
+	if len(missing.ROMs) > 0 {
+		return missing
+	}
 
-func (f byROMFilename) Len() int {
-	return len(f)
-}
+	f.tracker.wrap(g.mameGame, readers)
 
-func (f byROMFilename) Swap(i, j int) {
-	f[i], f[j] = f[j], f[i]
+	return g.reader(f, g.mameGame, readers)
 }
 
-func (f byROMFilename) Less(i, j int) bool {
+// romFilenameLess orders ROM filenames by area letter and then by number
+// with any SMA ROM image first as it's mapped below the P ROM
+func romFilenameLess(a, b string) bool {
+	if smaA, smaB := isSMAFilename(a), isSMAFilename(b); smaA != smaB {
+		return smaA
+	}
 	re := regexp.MustCompile(`([psmvc])(\d+)`)
-	m1 := re.FindStringSubmatch(strings.ToLower(f[i]))
-	m2 := re.FindStringSubmatch(strings.ToLower(f[j]))
+	m1 := re.FindStringSubmatch(strings.ToLower(a))
+	m2 := re.FindStringSubmatch(strings.ToLower(b))
 	if m1 == nil || m2 == nil {
 		// If neither file match, just sort on the whole filename
-		return f[i] < f[j]
+		return a < b
 	}
 	if m1[1] != m2[1] {
 		return m1[1] < m2[1]
@@ -276,62 +505,91 @@ func (f byROMFilename) Less(i, j int) bool {
 	return n1 < n2
 }
 
-func (f *File) readGenericROM(path string) error {
-	r, err := rom.NewReader(path)
+// isSMAFilename reports whether a ROM image is the P ROM of an SMA chip,
+// such as MAME's ka.neo-sma
+func isSMAFilename(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(filename), "neo-sma")
+}
+
+// filenameArea guesses which area a ROM image belongs to from its filename
+func filenameArea(filename string) (int, bool) {
+	if isSMAFilename(filename) {
+		return P, true
+	} else if match := regexp.MustCompile(`([psmc])\d+`).FindStringSubmatch(strings.ToLower(filename)); match != nil {
+		areaFromString := map[string]int{
+			"p": P,
+			"s": S,
+			"m": M,
+			"c": C,
+		}
+		return areaFromString[match[1]], true
+	} else if regexp.MustCompile(`[vV](?:1\d|\d[^\d])`).MatchString(filename) {
+		return V1, true
+	} else if regexp.MustCompile(`[vV]2\d`).MatchString(filename) {
+		return V2, true
+	}
+	return Areas, false
+}
+
+func (f *File) readGenericROM(set romSet) error {
+	report := f.tracker.result
+	s, err := set.source()
 	if err != nil {
 		return err
 	}
-	defer r.Close()
 
-	files := r.Files()
-	sort.Sort(byROMFilename(files))
+	sr := newSourceReaders(s)
+	defer sr.Close()
+
+	files := make([]indexedFile, len(s.files))
+	copy(files, s.files)
+	sort.Slice(files, func(i, j int) bool {
+		return romFilenameLess(files[i].name, files[j].name)
+	})
 
 	g := mameGame{}
 
 	readers := make([][]io.Reader, Areas)
 
 	for _, file := range files {
-		area := Areas
-		if match := regexp.MustCompile(`([psmc])\d+`).FindStringSubmatch(strings.ToLower(file)); match != nil {
-			areaFromString := map[string]int{
-				"p": P,
-				"s": S,
-				"m": M,
-				"c": C,
-			}
-			area = areaFromString[match[1]]
-		} else if regexp.MustCompile(`[vV](?:1\d|\d[^\d])`).MatchString(file) {
-			area = V1
-		} else if regexp.MustCompile(`[vV]2\d`).MatchString(file) {
-			area = V2
-		} else {
+		area, ok := filenameArea(file.name)
+		if !ok {
 			continue
 		}
 
-		size, err := r.Size(file)
-		if err != nil {
-			return err
-		}
-
-		crc, err := r.Checksum(file, rom.CRC32)
-		if err != nil {
-			return err
-		}
-
-		g.area[area].size += size
+		g.area[area].size += file.size
 		g.area[area].rom = append(g.area[area].rom, mameROM{
-			filename: file,
-			size:     size,
-			crc:      crc,
+			filename: file.name,
+			size:     file.size,
+			crc:      file.crc,
 		})
 
-		reader, err := r.Open(file)
+		reader, err := sr.open(0, file.name)
 		if err != nil {
 			return err
 		}
-		defer reader.Close()
 		readers[area] = append(readers[area], reader)
+
+		report.Sources = append(report.Sources, ROMSource{
+			Area:     area,
+			Filename: file.name,
+			Source:   s.path,
+			File:     file.name,
+			Size:     file.size,
+			CRC:      file.crc,
+		})
 	}
 
+	report.Method = MethodGeneric
+
+	f.tracker.wrap(g, readers)
+
+	if scheme := f.tracker.opts.Scheme; scheme != nil {
+		report.Reader = scheme.String()
+		return scheme.read(f, g, readers)
+	}
+
+	report.Reader = "common"
+
 	return common(f, g, readers)
 }
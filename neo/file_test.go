@@ -1,6 +1,13 @@
 package neo
 
 import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,3 +16,161 @@ import (
 func TestArea(t *testing.T) {
 	assert.Equal(t, 6, Areas)
 }
+
+func TestHeaderSize(t *testing.T) {
+	assert.Equal(t, headerSize, binary.Size(fileFields{}))
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	f := &File{
+		Year:         1998,
+		Genre:        Fighting,
+		Screenshot:   74,
+		NGH:          0x242,
+		Name:         "The King of Fighters '98",
+		Manufacturer: "SNK",
+	}
+	f.ROM[P] = bytes.Repeat([]byte{0x01}, 16)
+	f.ROM[C] = bytes.Repeat([]byte{0x02}, 32)
+	f.Size[P], f.Size[C] = 16, 32
+
+	b, err := f.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, headerSize+48, len(b))
+
+	g := new(File)
+	n, err := g.ReadFrom(bytes.NewReader(b))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(b)), n)
+	assert.Equal(t, f.Name, g.Name)
+	assert.Equal(t, f.ROM[C], g.ROM[C])
+	assert.Nil(t, g.ROM[S])
+
+	r, err := Open(bytes.NewReader(b))
+	assert.Nil(t, err)
+	assert.Equal(t, f.Manufacturer, r.Manufacturer)
+	assert.Equal(t, int64(32), r.ROM[C].Size())
+
+	_, err = Open(bytes.NewReader(b[:len(b)-1]))
+	assert.NotNil(t, err)
+
+	_, err = Open(bytes.NewReader(append(b, 0)))
+	assert.Equal(t, errTooMuch, err)
+}
+
+func TestReadHeader(t *testing.T) {
+	f := &File{
+		Name: "Metal Slug",
+		NGH:  0x201,
+	}
+	f.ROM[P] = bytes.Repeat([]byte{0x01}, 16)
+	f.Size[P] = 16
+
+	b, err := f.MarshalBinary()
+	assert.Nil(t, err)
+
+	h, err := ReadHeader(bytes.NewReader(b))
+	assert.Nil(t, err)
+	assert.Equal(t, f.Name, h.Name)
+	assert.Equal(t, f.NGH, h.NGH)
+	assert.Equal(t, f.Size, h.Size)
+
+	// Hide the io.Seeker implementation
+	_, err = ReadHeader(io.MultiReader(bytes.NewReader(b[:len(b)-1])))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = ReadHeader(bytes.NewReader(append(b, 0)))
+	assert.Equal(t, errTooMuch, err)
+}
+
+func TestFileReserved(t *testing.T) {
+	b, err := new(File).MarshalBinary()
+	assert.Nil(t, err)
+
+	// Pretend a newer tool stored something in the reserved area
+	b[3] = 2
+	b[0x100] = 0xaa
+
+	f := new(File)
+	assert.Nil(t, f.UnmarshalBinary(b))
+	assert.Equal(t, []int{0x100}, f.NonZeroReserved())
+
+	f.Name = "Changed"
+	c, err := f.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, byte(2), c[3])
+	assert.Equal(t, b[0x5e:], c[0x5e:])
+
+	r, err := Open(bytes.NewReader(c))
+	assert.Nil(t, err)
+	assert.Equal(t, byte(2), r.Version)
+	assert.Equal(t, []int{0x100}, r.NonZeroReserved())
+}
+
+func TestHeaderValidate(t *testing.T) {
+	h := Header{
+		Name:         strings.Repeat("n", nameLength),
+		Manufacturer: strings.Repeat("m", manufacturerLength),
+	}
+	assert.Nil(t, h.Validate())
+
+	h.Name += "n"
+	assert.NotNil(t, h.Validate())
+
+	h.Name = ""
+	h.Manufacturer += "m"
+	assert.NotNil(t, h.Validate())
+}
+
+func TestUpdateHeader(t *testing.T) {
+	f := &File{
+		Year:         1990,
+		NGH:          0x5,
+		Name:         "Magician Lord",
+		Manufacturer: "Alpha Denshi",
+	}
+	f.ROM[P] = bytes.Repeat([]byte{0x01}, 16)
+	f.ROM[C] = bytes.Repeat([]byte{0x02}, 32)
+	f.Size[P], f.Size[C] = 16, 32
+
+	b, err := f.MarshalBinary()
+	assert.Nil(t, err)
+	// Something only a newer tool knows about
+	b[0x100] = 0xaa
+
+	path := filepath.Join(t.TempDir(), "maglord.neo")
+	assert.Nil(t, ioutil.WriteFile(path, b, 0666))
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	h, err := ReadHeader(file)
+	assert.Nil(t, err)
+
+	h.Name, h.Year, h.Genre = "Magician Lord (hack)", 2020, Action
+	assert.Nil(t, UpdateHeader(file, h))
+
+	h.Name = strings.Repeat("n", nameLength+1)
+	assert.NotNil(t, UpdateHeader(file, h))
+
+	h.Name = "Magician Lord"
+	h.Size[C] = 64
+	assert.Equal(t, errSizeMismatch, UpdateHeader(file, h))
+
+	c, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, len(b), len(c))
+	// Only the metadata changes
+	assert.Equal(t, b[:0x1c], c[:0x1c])
+	assert.Equal(t, b[0x5e:], c[0x5e:])
+
+	g := new(File)
+	assert.Nil(t, g.UnmarshalBinary(c))
+	assert.Equal(t, "Magician Lord (hack)", g.Name)
+	assert.Equal(t, uint32(2020), g.Year)
+	assert.Equal(t, Action, g.Genre)
+	assert.Equal(t, f.Manufacturer, g.Manufacturer)
+	assert.Equal(t, f.NGH, g.NGH)
+	assert.Equal(t, []int{0x100}, g.NonZeroReserved())
+}
@@ -0,0 +1,124 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// fsSource reads every regular file in a file system as a ROM image
+type fsSource struct {
+	fsys  fs.FS
+	files []string
+}
+
+func openFS(fsys fs.FS) (romSource, error) {
+	s := &fsSource{fsys: fsys}
+
+	if err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			s.files = append(s.files, name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *fsSource) Files() []string {
+	return s.files
+}
+
+func (s *fsSource) Open(name string) (io.ReadCloser, error) {
+	return s.fsys.Open(name)
+}
+
+func (s *fsSource) Size(name string) (uint64, error) {
+	fi, err := fs.Stat(s.fsys, name)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(fi.Size()), nil
+}
+
+func (s *fsSource) CRC(name string) ([]byte, error) {
+	f, err := s.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := crc32.NewIEEE()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+func (s *fsSource) Close() error {
+	return nil
+}
+
+// fsSet is a ROM set held in a file system with the file systems of its
+// ancestors
+type fsSet struct {
+	base string
+	all  []*sourceIndex
+}
+
+func (fss *fsSet) name() string {
+	return fss.base
+}
+
+func (fss *fsSet) source() (*sourceIndex, error) {
+	return fss.all[0], nil
+}
+
+func (fss *fsSet) sources(string) ([]*sourceIndex, error) {
+	return fss.all, nil
+}
+
+// NewFileFS is like NewFile but reads the ROM images from the files in fsys
+// instead. The game is chosen using name in the same way as the path passed
+// to NewFile. Any ROM images belonging to the parent of a clone, or further
+// ancestors, are read from the file systems in parents in that order
+func NewFileFS(fsys fs.FS, name string, parents ...fs.FS) (*File, error) {
+	set := &fsSet{
+		base: strings.TrimSuffix(path.Base(name), path.Ext(name)),
+	}
+
+	// Label each ancestor after the game it's expected to be
+	labels := []string{name}
+	for game := mameGames[set.base].parent; game != "" && len(labels) <= len(parents); game = mameGames[game].parent {
+		labels = append(labels, game)
+	}
+
+	for i, fsys := range append([]fs.FS{fsys}, parents...) {
+		label := fmt.Sprintf("%s parent %d", name, i)
+		if i < len(labels) {
+			label = labels[i]
+		}
+
+		fsys := fsys
+		s, err := newSourceIndex(label, func() (romSource, error) {
+			return openFS(fsys)
+		})
+		if err != nil {
+			return nil, err
+		}
+		set.all = append(set.all, s)
+	}
+
+	f, _, err := newFile(context.Background(), set, nil)
+	return f, err
+}
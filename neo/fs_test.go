@@ -0,0 +1,27 @@
+package neo
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFileFS(t *testing.T) {
+	f := testFile(0x40)
+	zr, files := exportMAME(t, f, "not a game")
+
+	fsys := make(fstest.MapFS)
+	for name, data := range files {
+		fsys[name] = &fstest.MapFile{Data: data}
+	}
+
+	n, err := NewFileFS(fsys, "not a game.zip")
+	assert.Nil(t, err)
+	assert.Equal(t, f.ROM, n.ROM)
+
+	// A zip.Reader is itself a file system
+	n, err = NewFileFS(zr, "not a game.zip")
+	assert.Nil(t, err)
+	assert.Equal(t, f.ROM, n.ROM)
+}
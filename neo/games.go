@@ -2,15 +2,7 @@
 
 package neo
 
-var mameGames = map[string]struct {
-	mameGame
-	reader       gameReader
-	name         string
-	year         uint32
-	manufacturer string
-	genre        Genre
-	screenshot   uint32
-}{
+var mameGames = map[string]mameEntry{
 	"nam1975": {
 		mameGame{
 			"",
@@ -24,6 +16,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x9f, 0xc9, 0x51},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -34,6 +27,7 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x88, 0xba, 0x51},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -44,6 +38,7 @@ var mameGames = map[string]struct {
 							[]byte{0xba, 0x87, 0x44, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -54,6 +49,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0xc3, 0xd5, 0xe5},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -74,6 +70,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdf, 0x46, 0x8e, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -109,10 +106,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe6, 0x2b, 0xed, 0x58},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"NAM-1975 (NGM-001 ~ NGH-001)",
 		1990,
 		"SNK",
@@ -132,6 +131,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc1, 0x00, 0xb5, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -142,6 +142,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x7f, 0xd0, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -152,6 +153,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0xca, 0xa4, 0xee},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -177,6 +179,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0xe1, 0x1c, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -187,6 +190,7 @@ var mameGames = map[string]struct {
 							[]byte{0x04, 0xa7, 0x33, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -222,10 +226,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x3c, 0xad, 0x41},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Baseball Stars Professional (NGM-002)",
 		1990,
 		"SNK",
@@ -245,6 +251,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3b, 0xc7, 0x79, 0x0e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -255,6 +262,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x7f, 0xd0, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -265,6 +273,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0xca, 0xa4, 0xee},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -290,6 +299,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0xe1, 0x1c, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -300,6 +310,7 @@ var mameGames = map[string]struct {
 							[]byte{0x04, 0xa7, 0x33, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -335,10 +346,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x3c, 0xad, 0x41},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Baseball Stars Professional (NGH-002)",
 		1990,
 		"SNK",
@@ -363,6 +376,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb7, 0x80, 0x9a, 0x8f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -373,6 +387,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0x3e, 0xb9, 0xb1},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -383,6 +398,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0xc5, 0x45, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -393,6 +409,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0x97, 0xf1, 0xcb},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -418,6 +435,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xa0, 0xf5, 0x01},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -463,10 +481,12 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x2a, 0xf2, 0x2d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Top Player's Golf (NGM-003 ~ NGH-003)",
 		1990,
 		"SNK",
@@ -486,6 +506,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0x6f, 0x53, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -496,6 +517,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0xd0, 0x5a, 0x06},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -506,6 +528,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0x1f, 0xbb, 0x3b},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -521,6 +544,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x03, 0x31, 0x7b},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -541,6 +565,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb3, 0xe7, 0xee, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -566,10 +591,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0x81, 0xcb, 0x29},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Mahjong Kyo Retsuden (NGM-004 ~ NGH-004)",
 		1990,
 		"SNK",
@@ -589,6 +616,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbd, 0x0a, 0x49, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -599,6 +627,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x53, 0x69, 0xa2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -609,6 +638,7 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0x25, 0x9f, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -619,6 +649,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x04, 0x55, 0xfd},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -634,6 +665,7 @@ var mameGames = map[string]struct {
 							[]byte{0x23, 0x2c, 0xfd, 0x04},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -669,10 +701,12 @@ var mameGames = map[string]struct {
 							[]byte{0xab, 0x7a, 0xc1, 0x42},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Magician Lord (NGM-005)",
 		1990,
 		"Alpha Denshi Co.",
@@ -692,6 +726,7 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x90, 0x43, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -702,6 +737,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x53, 0x69, 0xa2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -712,6 +748,7 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0x25, 0x9f, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -722,6 +759,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x04, 0x55, 0xfd},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -737,6 +775,7 @@ var mameGames = map[string]struct {
 							[]byte{0x23, 0x2c, 0xfd, 0x04},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -772,10 +811,12 @@ var mameGames = map[string]struct {
 							[]byte{0xab, 0x7a, 0xc1, 0x42},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Magician Lord (NGH-005)",
 		1990,
 		"Alpha Denshi Co.",
@@ -795,6 +836,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0xaa, 0xf5, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -805,6 +847,7 @@ var mameGames = map[string]struct {
 							[]byte{0xeb, 0x51, 0x89, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -815,6 +858,7 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0xe7, 0xb4, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -830,6 +874,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xfd, 0x23, 0x71},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -855,6 +900,7 @@ var mameGames = map[string]struct {
 							[]byte{0x89, 0xfb, 0xb8, 0x25},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -880,10 +926,12 @@ var mameGames = map[string]struct {
 							[]byte{0x20, 0x5e, 0x32, 0x08},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Riding Hero (NGM-006 ~ NGH-006)",
 		1990,
 		"SNK",
@@ -903,6 +951,7 @@ var mameGames = map[string]struct {
 							[]byte{0x52, 0x44, 0x56, 0x46},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -913,6 +962,7 @@ var mameGames = map[string]struct {
 							[]byte{0xeb, 0x51, 0x89, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -923,6 +973,7 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0xe7, 0xb4, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -938,6 +989,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xfd, 0x23, 0x71},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -963,6 +1015,7 @@ var mameGames = map[string]struct {
 							[]byte{0x89, 0xfb, 0xb8, 0x25},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -988,10 +1041,12 @@ var mameGames = map[string]struct {
 							[]byte{0x20, 0x5e, 0x32, 0x08},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Riding Hero (set 2)",
 		1990,
 		"SNK",
@@ -1016,6 +1071,7 @@ var mameGames = map[string]struct {
 							[]byte{0xeb, 0x9c, 0x10, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1026,6 +1082,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0xec, 0x9a, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1036,6 +1093,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0xdf, 0xe2, 0xcd},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1051,10 +1109,12 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xe9, 0xb5, 0x74},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -1080,10 +1140,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x58, 0x83, 0x49},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Alpha Mission II / ASO II - Last Guardian (NGM-007 ~ NGH-007)",
 		1991,
 		"SNK",
@@ -1108,6 +1170,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0x0b, 0x43, 0x5c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1118,6 +1181,7 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0xc9, 0xae, 0x2e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1128,6 +1192,7 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x76, 0xb4, 0x64},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1148,6 +1213,7 @@ var mameGames = map[string]struct {
 							[]byte{0x25, 0xe6, 0x0f, 0x25},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -1158,6 +1224,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x44, 0xb7, 0x5a},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -1203,10 +1270,12 @@ var mameGames = map[string]struct {
 							[]byte{0x44, 0x83, 0xe2, 0xcf},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2p,
+		"kotm2p",
 		"Alpha Mission II / ASO II - Last Guardian (prototype)",
 		1991,
 		"SNK",
@@ -1226,6 +1295,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x5f, 0xcf, 0xbf},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1236,6 +1306,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0x9a, 0xfe, 0xe8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1246,6 +1317,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb5, 0x81, 0x98, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -1266,6 +1338,7 @@ var mameGames = map[string]struct {
 							[]byte{0x50, 0x5a, 0x01, 0xb5},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -1276,6 +1349,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0x5f, 0x90, 0x11},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -1311,10 +1385,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2e, 0xca, 0x8b, 0x19},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ninja Combat (NGM-009)",
 		1990,
 		"Alpha Denshi Co.",
@@ -1334,6 +1410,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x9f, 0x0a, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1344,6 +1421,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0x9a, 0xfe, 0xe8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1354,6 +1432,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb5, 0x81, 0x98, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -1374,6 +1453,7 @@ var mameGames = map[string]struct {
 							[]byte{0x50, 0x5a, 0x01, 0xb5},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -1384,6 +1464,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0x5f, 0x90, 0x11},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -1419,10 +1500,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2e, 0xca, 0x8b, 0x19},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ninja Combat (NGH-009)",
 		1990,
 		"Alpha Denshi Co.",
@@ -1442,6 +1525,7 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0xa6, 0xb4, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1452,6 +1536,7 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0xa3, 0x52, 0x64},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1462,6 +1547,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8b, 0xe3, 0xa0, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1487,6 +1573,7 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x89, 0x9b, 0xd2},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -1497,6 +1584,7 @@ var mameGames = map[string]struct {
 							[]byte{0x58, 0x6f, 0x4c, 0xb2},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -1532,10 +1620,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x95, 0xc5, 0x67},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Cyber-Lip (NGM-010)",
 		1990,
 		"SNK",
@@ -1560,6 +1650,7 @@ var mameGames = map[string]struct {
 							[]byte{0x81, 0x1a, 0x4f, 0xaf},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1570,6 +1661,7 @@ var mameGames = map[string]struct {
 							[]byte{0xec, 0x5f, 0xdb, 0x96},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1580,6 +1672,7 @@ var mameGames = map[string]struct {
 							[]byte{0xca, 0x66, 0x1f, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -1595,6 +1688,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0x51, 0x3d, 0x5a},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -1605,6 +1699,7 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x6c, 0xd0, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -1630,10 +1725,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb7, 0xf6, 0x31, 0x62},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Super Spy (NGM-011 ~ NGH-011)",
 		1990,
 		"SNK",
@@ -1653,6 +1750,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x16, 0x99, 0xc8},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1663,6 +1761,7 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x41, 0x97, 0x33},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1673,6 +1772,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0x68, 0x30, 0x92},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1688,10 +1788,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0d, 0xe5, 0x3d, 0x5e},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -1717,10 +1819,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe4, 0x00, 0x26, 0x51},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Mutation Nation (NGM-014 ~ NGH-014)",
 		1992,
 		"SNK",
@@ -1745,6 +1849,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0xaf, 0xdc, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1755,6 +1860,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x2e, 0xee, 0xb3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1765,6 +1871,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0xa9, 0xca, 0x10},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1780,10 +1887,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xc2, 0x3e, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -1809,10 +1918,12 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x05, 0x06, 0xe2},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"King of the Monsters (set 1)",
 		1991,
 		"SNK",
@@ -1837,6 +1948,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0xaf, 0xdc, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1847,6 +1959,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x2e, 0xee, 0xb3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1857,6 +1970,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0xa9, 0xca, 0x10},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1872,10 +1986,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xc2, 0x3e, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -1901,10 +2017,12 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x05, 0x06, 0xe2},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"King of the Monsters (set 2)",
 		1991,
 		"SNK",
@@ -1929,6 +2047,7 @@ var mameGames = map[string]struct {
 							[]byte{0x30, 0x24, 0xbb, 0xb3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -1939,6 +2058,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb2, 0x46, 0x20, 0x4d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -1949,6 +2069,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4f, 0x34, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -1964,10 +2085,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf6, 0x1e, 0x67, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -1993,10 +2116,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0xf3, 0xc9, 0xcb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Sengoku / Sengoku Denshou (NGM-017 ~ NGH-017)",
 		1991,
 		"SNK",
@@ -2021,6 +2146,7 @@ var mameGames = map[string]struct {
 							[]byte{0x30, 0x24, 0xbb, 0xb3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2031,6 +2157,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb2, 0x46, 0x20, 0x4d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2041,6 +2168,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4f, 0x34, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -2056,10 +2184,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf6, 0x1e, 0x67, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -2085,10 +2215,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0xf3, 0xc9, 0xcb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Sengoku / Sengoku Denshou (NGH-017, US)",
 		1991,
 		"SNK",
@@ -2108,6 +2240,7 @@ var mameGames = map[string]struct {
 							[]byte{0x40, 0x92, 0xc8, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2118,6 +2251,7 @@ var mameGames = map[string]struct {
 							[]byte{0x67, 0x99, 0xea, 0x0d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2128,6 +2262,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x93, 0x9e, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -2143,10 +2278,12 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x4e, 0xf2, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -2172,10 +2309,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xe0, 0xaf, 0xf7},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Burning Fight (NGM-018 ~ NGH-018)",
 		1991,
 		"SNK",
@@ -2195,6 +2334,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0xff, 0xcb, 0xf4},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2205,6 +2345,7 @@ var mameGames = map[string]struct {
 							[]byte{0x67, 0x99, 0xea, 0x0d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2215,6 +2356,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x93, 0x9e, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -2230,10 +2372,12 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x4e, 0xf2, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -2259,10 +2403,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xe0, 0xaf, 0xf7},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Burning Fight (NGH-018, US)",
 		1991,
 		"SNK",
@@ -2287,6 +2433,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0xae, 0x22, 0xfb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2297,6 +2444,7 @@ var mameGames = map[string]struct {
 							[]byte{0x67, 0x99, 0xea, 0x0d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2307,6 +2455,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0x0c, 0x04, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -2332,10 +2481,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0x4e, 0x3d, 0x69},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -2381,10 +2532,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x3b, 0x1f, 0x04},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2p,
+		"kotm2p",
 		"Burning Fight (prototype, ver 23.3, 910326)",
 		1991,
 		"SNK",
@@ -2409,6 +2562,7 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0x76, 0x2f, 0x68},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2419,6 +2573,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0xd1, 0x30, 0xe8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2429,6 +2584,7 @@ var mameGames = map[string]struct {
 							[]byte{0x47, 0x0d, 0xd5, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -2444,6 +2600,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0xae, 0x63, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2454,6 +2611,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0x3b, 0x4e, 0xda},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -2499,10 +2657,12 @@ var mameGames = map[string]struct {
 							[]byte{0x67, 0xcc, 0x9e, 0x34},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2p,
+		"kotm2p",
 		"Burning Fight (prototype, older)",
 		1991,
 		"SNK",
@@ -2522,6 +2682,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa2, 0xde, 0x84, 0x45},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2532,6 +2693,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5f, 0xcd, 0xc0, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2542,6 +2704,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd5, 0x68, 0xc1, 0x7d},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -2557,6 +2720,7 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0x9f, 0xaa, 0x57},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2567,6 +2731,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2e, 0xfd, 0x5a, 0xda},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -2582,10 +2747,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0xdd, 0x08, 0x02},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"League Bowling (NGM-019 ~ NGH-019)",
 		1990,
 		"SNK",
@@ -2610,6 +2777,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xcb, 0x22, 0xac},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2620,6 +2788,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0xd8, 0x3d, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2630,6 +2799,7 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0x40, 0x93, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -2645,6 +2815,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x9e, 0x6f, 0x03},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2655,6 +2826,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0xbf, 0x11, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -2680,10 +2852,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xde, 0x43, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ghost Pilots (NGM-020 ~ NGH-020)",
 		1991,
 		"SNK",
@@ -2708,6 +2882,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xcb, 0x22, 0xac},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2718,6 +2893,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0xd8, 0x3d, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2728,6 +2904,7 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0x40, 0x93, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -2743,6 +2920,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x9e, 0x6f, 0x03},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2753,6 +2931,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0xbf, 0x11, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -2778,10 +2957,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xde, 0x43, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ghost Pilots (NGH-020, US)",
 		1991,
 		"SNK",
@@ -2806,6 +2987,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x4d, 0xb1, 0xf3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2816,6 +2998,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0xd8, 0x3d, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -2826,6 +3009,7 @@ var mameGames = map[string]struct {
 							[]byte{0x32, 0xa1, 0x08, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -2846,6 +3030,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x9e, 0x6f, 0x03},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2856,6 +3041,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0xbf, 0x11, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -2901,10 +3087,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x56, 0x9e, 0x35},
 						},
 					},
+					0,
 				},
 			},
 		},
 		gpilotsp,
+		"gpilotsp",
 		"Ghost Pilots (prototype)",
 		1991,
 		"SNK",
@@ -2924,6 +3112,7 @@ var mameGames = map[string]struct {
 							[]byte{0x39, 0xc3, 0x47, 0x8f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2934,6 +3123,7 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0x56, 0xd7, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -2944,6 +3134,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x4b, 0xe5, 0xe8},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2954,6 +3145,7 @@ var mameGames = map[string]struct {
 							[]byte{0x66, 0xc1, 0xe5, 0xc4},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -2964,6 +3156,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0xd2, 0x0a, 0x86},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -2979,10 +3172,12 @@ var mameGames = map[string]struct {
 							[]byte{0x09, 0xed, 0x52, 0x58},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Puzzled / Joy Joy Kid (NGM-021 ~ NGH-021)",
 		1990,
 		"SNK",
@@ -3002,6 +3197,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6a, 0x2f, 0x6d, 0x4a},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3012,6 +3208,7 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0x3c, 0x36, 0x24},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3022,6 +3219,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x1d, 0x4a, 0xb6},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3037,10 +3235,12 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0xa5, 0x4d, 0x13},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -3066,10 +3266,12 @@ var mameGames = map[string]struct {
 							[]byte{0x71, 0xbf, 0xd4, 0x8a},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Blue's Journey / Raguy (ALM-001 ~ ALH-001)",
 		1990,
 		"Alpha Denshi Co.",
@@ -3089,6 +3291,7 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0xcb, 0xe7, 0xb2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3099,6 +3302,7 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0x3c, 0x36, 0x24},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3109,6 +3313,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x1d, 0x4a, 0xb6},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3124,10 +3329,12 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0xa5, 0x4d, 0x13},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -3153,10 +3360,12 @@ var mameGames = map[string]struct {
 							[]byte{0x71, 0xbf, 0xd4, 0x8a},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Blue's Journey / Raguy (ALH-001)",
 		1990,
 		"Alpha Denshi Co.",
@@ -3176,6 +3385,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x88, 0xfd, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3186,6 +3396,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x31, 0x81, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3196,6 +3407,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x21, 0x05, 0xe0},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -3206,10 +3418,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa5, 0x3e, 0x5b, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -3225,10 +3439,12 @@ var mameGames = map[string]struct {
 							[]byte{0x87, 0x6a, 0x99, 0xe6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Quiz Daisousa Sen - The Last Count Down (NGM-023 ~ NGH-023)",
 		1991,
 		"SNK",
@@ -3248,6 +3464,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0xf3, 0x5e, 0xae},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3258,6 +3475,7 @@ var mameGames = map[string]struct {
 							[]byte{0x53, 0xde, 0x93, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3268,6 +3486,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x7f, 0x53, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3278,10 +3497,12 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0x6f, 0x4a, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -3297,10 +3518,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe1, 0x82, 0xc8, 0x37},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Quiz Salibtamjeong - The Last Count Down (Korean localized Quiz Daisousa Sen)",
 		1995,
 		"SNK / Viccom",
@@ -3320,6 +3543,7 @@ var mameGames = map[string]struct {
 							[]byte{0x89, 0xc4, 0xab, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3330,6 +3554,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5c, 0xef, 0x5c, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3340,6 +3565,7 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0xc1, 0x97, 0x42},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3355,10 +3581,12 @@ var mameGames = map[string]struct {
 							[]byte{0x3c, 0x79, 0x97, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -3384,10 +3612,12 @@ var mameGames = map[string]struct {
 							[]byte{0x73, 0x82, 0xfe, 0xfb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Last Resort",
 		1992,
 		"SNK",
@@ -3412,6 +3642,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0x9e, 0xe9, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3422,6 +3653,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5c, 0xef, 0x5c, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3432,6 +3664,7 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0x12, 0x28, 0x75},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3457,10 +3690,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x44, 0x75, 0x4f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -3506,10 +3741,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfb, 0x57, 0x21, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2p,
+		"kotm2p",
 		"Last Resort (prototype)",
 		1992,
 		"SNK",
@@ -3529,6 +3766,7 @@ var mameGames = map[string]struct {
 							[]byte{0x43, 0x34, 0x4c, 0xb0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3539,6 +3777,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa4, 0x02, 0x20, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3549,6 +3788,7 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x27, 0x03, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3564,10 +3804,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc5, 0xe0, 0x52, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -3593,10 +3835,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe3, 0xec, 0xa6, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Eight Man (NGM-025 ~ NGH-025)",
 		1991,
 		"SNK / Pallas",
@@ -3616,6 +3860,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x38, 0x13, 0x27},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3626,6 +3871,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe5, 0x82, 0x4b, 0xaa},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3636,6 +3882,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xd5, 0xa2, 0x26},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -3646,6 +3893,7 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0xad, 0x44, 0x59},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -3656,6 +3904,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdf, 0x5b, 0x4e, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -3681,10 +3930,12 @@ var mameGames = map[string]struct {
 							[]byte{0x54, 0xe8, 0x76, 0x96},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Minasan no Okagesamadesu! Dai Sugoroku Taikai (MOM-001 ~ MOH-001)",
 		1990,
 		"Monolith Corp.",
@@ -3704,6 +3955,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0x56, 0x3f, 0x19},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3714,6 +3966,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbc, 0xd5, 0x02, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3724,6 +3977,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x28, 0x43, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -3734,10 +3988,12 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x06, 0x54, 0x52},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -3763,10 +4019,12 @@ var mameGames = map[string]struct {
 							[]byte{0x37, 0xef, 0x29, 0x8c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Legend of Success Joe / Ashita no Joe Densetsu",
 		1991,
 		"SNK",
@@ -3786,6 +4044,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd3, 0x96, 0xc9, 0xcb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3796,6 +4055,7 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x15, 0xb8, 0xfc},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3806,6 +4066,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0xf4, 0x66, 0xec},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3821,10 +4082,12 @@ var mameGames = map[string]struct {
 							[]byte{0x54, 0x99, 0x44, 0x55},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -3850,10 +4113,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0x0d, 0x1c, 0x4e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"2020 Super Baseball (set 1)",
 		1991,
 		"SNK / Pallas",
@@ -3873,6 +4138,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc5, 0x9b, 0xe3, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3883,6 +4149,7 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x15, 0xb8, 0xfc},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3893,6 +4160,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0xf4, 0x66, 0xec},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3908,10 +4176,12 @@ var mameGames = map[string]struct {
 							[]byte{0x54, 0x99, 0x44, 0x55},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -3937,10 +4207,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0x0d, 0x1c, 0x4e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"2020 Super Baseball (set 2)",
 		1991,
 		"SNK / Pallas",
@@ -3960,6 +4232,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0xd0, 0x48, 0xd7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -3970,6 +4243,7 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x15, 0xb8, 0xfc},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -3980,6 +4254,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0xf4, 0x66, 0xec},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -3995,10 +4270,12 @@ var mameGames = map[string]struct {
 							[]byte{0x54, 0x99, 0x44, 0x55},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -4024,10 +4301,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0x0d, 0x1c, 0x4e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"2020 Super Baseball (set 3)",
 		1991,
 		"SNK / Pallas",
@@ -4047,6 +4326,7 @@ var mameGames = map[string]struct {
 							[]byte{0x17, 0xf0, 0x34, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4057,6 +4337,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0x11, 0x71, 0x74},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4067,6 +4348,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcb, 0x37, 0x42, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4082,10 +4364,12 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0xa0, 0x43, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4111,10 +4395,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x29, 0x7d, 0xe8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Soccer Brawl (NGM-031)",
 		1991,
 		"SNK",
@@ -4134,6 +4420,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa2, 0x80, 0x1c, 0x24},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4144,6 +4431,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0x11, 0x71, 0x74},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4154,6 +4442,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcb, 0x37, 0x42, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4169,10 +4458,12 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0xa0, 0x43, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4198,10 +4489,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x29, 0x7d, 0xe8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Soccer Brawl (NGH-031)",
 		1991,
 		"SNK",
@@ -4221,6 +4514,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0x11, 0xcb, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4231,6 +4525,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x0d, 0xaa, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4241,6 +4536,7 @@ var mameGames = map[string]struct {
 							[]byte{0x35, 0xec, 0x95, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4256,10 +4552,12 @@ var mameGames = map[string]struct {
 							[]byte{0xeb, 0x95, 0xde, 0x70},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4285,10 +4583,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x25, 0x71, 0xde},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Robo Army",
 		1991,
 		"SNK",
@@ -4308,6 +4608,7 @@ var mameGames = map[string]struct {
 							[]byte{0x27, 0xc7, 0x73, 0xcb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4318,6 +4619,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x0d, 0xaa, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4328,6 +4630,7 @@ var mameGames = map[string]struct {
 							[]byte{0x35, 0xec, 0x95, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4343,10 +4646,12 @@ var mameGames = map[string]struct {
 							[]byte{0xeb, 0x95, 0xde, 0x70},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4372,10 +4677,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x25, 0x71, 0xde},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Robo Army (NGM-032 ~ NGH-032)",
 		1991,
 		"SNK",
@@ -4400,6 +4707,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x73, 0xaf, 0x1c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4410,6 +4718,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3c, 0x3b, 0xdf, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4420,6 +4729,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xe1, 0x0f, 0xfd},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4435,10 +4745,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0x2a, 0xe4, 0x7f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -4464,10 +4776,12 @@ var mameGames = map[string]struct {
 							[]byte{0x93, 0x97, 0x47, 0x6a},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fatal Fury - King of Fighters / Garou Densetsu - Shukumei no Tatakai (NGM-033 ~ NGH-033)",
 		1991,
 		"SNK",
@@ -4487,6 +4801,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0xef, 0x6b, 0x19},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4497,6 +4812,7 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0x72, 0xed, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4507,6 +4823,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0x1b, 0x16, 0xb8},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4522,10 +4839,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0xa1, 0x56, 0x86},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4551,10 +4870,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0xb1, 0x38, 0xcc},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Football Frenzy (NGM-034 ~ NGH-034)",
 		1992,
 		"SNK",
@@ -4574,6 +4895,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x66, 0xb6, 0xfa},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4584,6 +4906,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0xef, 0x44, 0x85},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4594,6 +4917,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x38, 0x5b, 0x96},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -4609,10 +4933,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbb, 0xf7, 0x93, 0x42},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -4638,10 +4964,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf2, 0x57, 0x7d, 0x22},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Bakatonosama Mahjong Manyuuki (MOM-002 ~ MOH-002)",
 		1991,
 		"Monolith Corp.",
@@ -4661,6 +4989,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0xf2, 0x55, 0x3c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4671,6 +5000,7 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0x65, 0x1f, 0x27},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4681,6 +5011,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x04, 0xb2, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -4691,10 +5022,12 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0xfe, 0xdf, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -4720,10 +5053,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x24, 0x39, 0x5d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Crossed Swords (ALM-002 ~ ALH-002)",
 		1991,
 		"Alpha Denshi Co.",
@@ -4748,6 +5083,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa5, 0x19, 0x3e, 0x2f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4758,6 +5094,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0xf6, 0x2a, 0xe3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4768,6 +5105,7 @@ var mameGames = map[string]struct {
 							[]byte{0x09, 0x08, 0x70, 0x7e},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -4783,10 +5121,12 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0xd8, 0xd1, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -4812,10 +5152,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa4, 0x51, 0x3e, 0xcf},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Thrash Rally (ALM-003 ~ ALH-003)",
 		1991,
 		"Alpha Denshi Co.",
@@ -4840,6 +5182,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0x66, 0x1a, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4850,6 +5193,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xee, 0x05, 0x3a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4860,6 +5204,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x5b, 0x2a, 0xd5},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -4875,10 +5220,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xa6, 0x2a, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -4904,10 +5251,12 @@ var mameGames = map[string]struct {
 							[]byte{0x81, 0xc9, 0xc2, 0x50},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"King of the Monsters 2 - The Next Thing (NGM-039 ~ NGH-039)",
 		1992,
 		"SNK",
@@ -4932,6 +5281,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0x66, 0x1a, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -4942,6 +5292,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xee, 0x05, 0x3a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -4952,6 +5303,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x5b, 0x2a, 0xd5},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -4967,10 +5319,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xa6, 0x2a, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -4996,10 +5350,12 @@ var mameGames = map[string]struct {
 							[]byte{0x81, 0xc9, 0xc2, 0x50},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"King of the Monsters 2 - The Next Thing (older)",
 		1992,
 		"SNK",
@@ -5024,6 +5380,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbc, 0x96, 0x91, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5034,6 +5391,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xee, 0x05, 0x3a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5044,6 +5402,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x5b, 0x2a, 0xd5},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -5079,10 +5438,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd1, 0xdd, 0x3f, 0xd6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -5128,10 +5489,12 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x2e, 0x92, 0x67},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2p,
+		"kotm2p",
 		"King of the Monsters 2 - The Next Thing (prototype)",
 		1992,
 		"SNK",
@@ -5151,6 +5514,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0xde, 0x02, 0xc2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5161,6 +5525,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0x98, 0x02, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5171,6 +5536,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0xde, 0x4b, 0xca},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -5186,10 +5552,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc5, 0xce, 0xce, 0x01},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -5215,10 +5583,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x9e, 0x99, 0x30},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"Sengoku 2 / Sengoku Denshou 2",
 		1993,
 		"SNK",
@@ -5238,6 +5608,7 @@ var mameGames = map[string]struct {
 							[]byte{0x52, 0x35, 0x67, 0xfd},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5248,6 +5619,7 @@ var mameGames = map[string]struct {
 							[]byte{0x01, 0x5c, 0x5c, 0x94},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5258,6 +5630,7 @@ var mameGames = map[string]struct {
 							[]byte{0x15, 0xc1, 0x77, 0xa6},
 						},
 					},
+					0,
 				},
 				{
 					2621440,
@@ -5278,10 +5651,12 @@ var mameGames = map[string]struct {
 							[]byte{0xaf, 0xaa, 0x01, 0x80},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -5307,10 +5682,12 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x45, 0x7a, 0x0c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Baseball Stars 2",
 		1992,
 		"SNK",
@@ -5330,6 +5707,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x71, 0x9d, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5340,6 +5718,7 @@ var mameGames = map[string]struct {
 							[]byte{0x16, 0x4f, 0xd6, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5350,6 +5729,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbb, 0x19, 0x99, 0x5d},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -5365,10 +5745,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc6, 0x47, 0x4b, 0x59},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					3145728,
@@ -5394,10 +5776,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x02, 0x21, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Quiz Meitantei Neo & Geo - Quiz Daisousa Sen Part 2 (NGM-042 ~ NGH-042)",
 		1992,
 		"SNK",
@@ -5417,6 +5801,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0xbd, 0xd9, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5427,6 +5812,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc3, 0x62, 0xd4, 0x84},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5437,6 +5823,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0xab, 0x59, 0xcb},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5452,10 +5839,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc6, 0x9a, 0x82, 0x7b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -5481,10 +5870,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x87, 0xe5, 0xc0},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"3 Count Bout / Fire Suplex (NGM-043 ~ NGH-043)",
 		1993,
 		"SNK",
@@ -5504,6 +5895,7 @@ var mameGames = map[string]struct {
 							[]byte{0xca, 0x9f, 0x7a, 0x6d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5514,6 +5906,7 @@ var mameGames = map[string]struct {
 							[]byte{0x89, 0x90, 0x3f, 0x39},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5524,6 +5917,7 @@ var mameGames = map[string]struct {
 							[]byte{0x09, 0x87, 0xe4, 0xbb},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5539,10 +5933,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x0f, 0x8e, 0x23},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -5568,10 +5964,12 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0x35, 0xfb, 0xaa},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"Art of Fighting / Ryuuko no Ken (NGM-044 ~ NGH-044)",
 		1992,
 		"SNK",
@@ -5596,6 +5994,7 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x74, 0x5b, 0x94},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5606,6 +6005,7 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x42, 0xa4, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5616,6 +6016,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x17, 0x06, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5631,10 +6032,12 @@ var mameGames = map[string]struct {
 							[]byte{0x56, 0x8b, 0x20, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -5670,10 +6073,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0x30, 0x61, 0x2e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Samurai Shodown / Samurai Spirits (NGM-045)",
 		1993,
 		"SNK",
@@ -5698,6 +6103,7 @@ var mameGames = map[string]struct {
 							[]byte{0x38, 0xee, 0x9b, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5708,6 +6114,7 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x42, 0xa4, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5718,6 +6125,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x17, 0x06, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5733,10 +6141,12 @@ var mameGames = map[string]struct {
 							[]byte{0x56, 0x8b, 0x20, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					9437184,
@@ -5772,10 +6182,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0xa7, 0x12, 0x52},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Samurai Shodown / Samurai Spirits (NGH-045)",
 		1993,
 		"SNK",
@@ -5800,6 +6212,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x82, 0xcb, 0x3e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5810,6 +6223,7 @@ var mameGames = map[string]struct {
 							[]byte{0x14, 0xb0, 0x1d, 0x7b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5820,6 +6234,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3f, 0x84, 0xbb, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5845,10 +6260,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0x54, 0xc1, 0x87},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -5894,10 +6311,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc9, 0x44, 0xe0, 0x3d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Top Hunter - Roddy & Cathy (NGM-046)",
 		1994,
 		"SNK",
@@ -5922,6 +6341,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x82, 0xcb, 0x3e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -5932,6 +6352,7 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0xe9, 0xac, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -5942,6 +6363,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3f, 0x84, 0xbb, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -5967,10 +6389,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0x54, 0xc1, 0x87},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -6016,10 +6440,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc9, 0x44, 0xe0, 0x3d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Top Hunter - Roddy & Cathy (NGH-046)",
 		1994,
 		"SNK",
@@ -6039,6 +6465,7 @@ var mameGames = map[string]struct {
 							[]byte{0xec, 0xfd, 0xbb, 0x69},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6049,6 +6476,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd7, 0xdb, 0xbf, 0x39},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6059,6 +6487,7 @@ var mameGames = map[string]struct {
 							[]byte{0x82, 0x0b, 0x0b, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -6074,10 +6503,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2c, 0x9a, 0x4b, 0x33},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -6103,10 +6534,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0xe2, 0x74, 0x32},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"Fatal Fury 2 / Garou Densetsu 2 - Arata-naru Tatakai (NGM-047 ~ NGH-047)",
 		1992,
 		"SNK",
@@ -6126,6 +6559,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0x81, 0x8c, 0xbb},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6136,6 +6570,7 @@ var mameGames = map[string]struct {
 							[]byte{0x82, 0x85, 0xb2, 0x5a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6146,6 +6581,7 @@ var mameGames = map[string]struct {
 							[]byte{0x31, 0x04, 0x67, 0xc7},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -6156,10 +6592,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x94, 0x7d, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -6175,10 +6613,12 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0xc4, 0x8a, 0xd8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Janshin Densetsu - Quest of Jongmaster",
 		1994,
 		"Aicom",
@@ -6203,6 +6643,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2f, 0x06, 0x22, 0x09},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6213,6 +6654,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0x49, 0xde, 0x5d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6223,6 +6665,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xd2, 0xac, 0xf4},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -6233,10 +6676,12 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0x43, 0xcb, 0x89},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -6252,10 +6697,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0x70, 0x24, 0xf7},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Andro Dunos (NGM-049 ~ NGH-049)",
 		1992,
 		"SNK",
@@ -6275,6 +6722,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x09, 0x7c, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6285,6 +6733,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdb, 0x8f, 0x9c, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6295,6 +6744,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0xcf, 0x07, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					1572864,
@@ -6310,10 +6760,12 @@ var mameGames = map[string]struct {
 							[]byte{0x80, 0xb8, 0xa9, 0x84},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -6339,10 +6791,12 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0x45, 0xea, 0xdb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ninja Commando",
 		1992,
 		"Alpha Denshi Co.",
@@ -6362,6 +6816,7 @@ var mameGames = map[string]struct {
 							[]byte{0x17, 0xaa, 0x89, 0x9d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6372,6 +6827,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0xea, 0x57, 0x58},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6382,6 +6838,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x69, 0xf2, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -6397,10 +6854,12 @@ var mameGames = map[string]struct {
 							[]byte{0x57, 0x58, 0xf3, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -6416,10 +6875,12 @@ var mameGames = map[string]struct {
 							[]byte{0x40, 0xd6, 0x9f, 0x1e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		viewpoin,
+		"viewpoin",
 		"Viewpoint",
 		1992,
 		"Sammy / Aicom",
@@ -6444,6 +6905,7 @@ var mameGames = map[string]struct {
 							[]byte{0x31, 0x12, 0x91, 0x94},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6454,6 +6916,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0xea, 0x57, 0x58},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6464,6 +6927,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x69, 0xf2, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -6489,10 +6953,12 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0x2b, 0x92, 0x92},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -6538,10 +7004,12 @@ var mameGames = map[string]struct {
 							[]byte{0x43, 0x9c, 0x90, 0x36},
 						},
 					},
+					0,
 				},
 			},
 		},
 		gpilotsp,
+		"gpilotsp",
 		"Viewpoint (prototype)",
 		1992,
 		"Sammy / Aicom",
@@ -6561,6 +7029,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9c, 0xd9, 0x72, 0x56},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6571,6 +7040,7 @@ var mameGames = map[string]struct {
 							[]byte{0x97, 0x68, 0x98, 0x04},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6581,6 +7051,7 @@ var mameGames = map[string]struct {
 							[]byte{0x49, 0xf1, 0x7d, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -6591,10 +7062,12 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0xc0, 0x97, 0xa5},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -6610,10 +7083,12 @@ var mameGames = map[string]struct {
 							[]byte{0x77, 0x6a, 0x2d, 0x1f},
 						},
 					},
+					0,
 				},
 			},
 		},
 		viewpoin,
+		"viewpoin",
 		"Super Sidekicks / Tokuten Ou",
 		1992,
 		"SNK",
@@ -6638,6 +7113,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x33, 0xe8, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6648,6 +7124,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8c, 0x2c, 0x2d, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6658,6 +7135,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0xd9, 0xd0, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -6673,10 +7151,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0xea, 0x8f, 0x66},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -6702,10 +7182,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0x70, 0xd9, 0x54},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"World Heroes (ALM-005)",
 		1992,
 		"Alpha Denshi Co.",
@@ -6730,6 +7212,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x98, 0xed, 0x45},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6740,6 +7223,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8c, 0x2c, 0x2d, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6750,6 +7234,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0xd9, 0xd0, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -6765,10 +7250,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0xea, 0x8f, 0x66},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -6794,10 +7281,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0x70, 0xd9, 0x54},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"World Heroes (ALH-005)",
 		1992,
 		"Alpha Denshi Co.",
@@ -6822,6 +7311,7 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0xf2, 0xb1, 0x58},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6832,6 +7322,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8c, 0x2c, 0x2d, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6842,6 +7333,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0xd9, 0xd0, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -6857,10 +7349,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0xea, 0x8f, 0x66},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -6886,10 +7380,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0x70, 0xd9, 0x54},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kotm2,
+		"kotm2",
 		"World Heroes (set 3)",
 		1992,
 		"Alpha Denshi Co.",
@@ -6909,6 +7405,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf1, 0x0a, 0x20, 0x42},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -6919,6 +7416,7 @@ var mameGames = map[string]struct {
 							[]byte{0x82, 0x59, 0x76, 0xc1},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -6929,6 +7427,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf6, 0xe7, 0x7c, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -6949,10 +7448,12 @@ var mameGames = map[string]struct {
 							[]byte{0x83, 0xcf, 0x32, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -6998,10 +7499,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfe, 0x0a, 0x23, 0x5d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '94 (NGM-055 ~ NGH-055)",
 		1994,
 		"SNK",
@@ -7021,6 +7524,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa3, 0xb1, 0xd0, 0x21},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7031,6 +7535,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8b, 0x02, 0x63, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7041,6 +7546,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf2, 0x7e, 0x9d, 0x52},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -7061,10 +7567,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd1, 0x68, 0xc3, 0x01},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -7110,10 +7618,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe5, 0x46, 0xd7, 0xa8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Art of Fighting 2 / Ryuuko no Ken 2 (NGM-056)",
 		1994,
 		"SNK",
@@ -7138,6 +7648,7 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0xd6, 0x30, 0x1c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7148,6 +7659,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8b, 0x02, 0x63, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7158,6 +7670,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf2, 0x7e, 0x9d, 0x52},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -7178,10 +7691,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd1, 0x68, 0xc3, 0x01},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -7227,10 +7742,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe5, 0x46, 0xd7, 0xa8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Art of Fighting 2 / Ryuuko no Ken 2 (NGH-056)",
 		1994,
 		"SNK",
@@ -7250,6 +7767,7 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0xa8, 0x91, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7260,6 +7778,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0xae, 0xb3, 0xa4},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7270,6 +7789,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xa3, 0xbc, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -7285,10 +7805,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc1, 0x31, 0x7f, 0xf4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -7324,10 +7846,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0x3d, 0x1d, 0xe3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"World Heroes 2 (ALM-006 ~ ALH-006)",
 		1993,
 		"ADK",
@@ -7347,6 +7871,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0xe9, 0xaf, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7357,6 +7882,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0xae, 0xb3, 0xa4},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7367,6 +7893,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xa3, 0xbc, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -7382,10 +7909,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc1, 0x31, 0x7f, 0xf4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -7421,10 +7950,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0x3d, 0x1d, 0xe3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"World Heroes 2 (ALH-006)",
 		1993,
 		"ADK",
@@ -7449,6 +7980,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd7, 0xc7, 0x1a, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7459,6 +7991,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2d, 0xf0, 0x31, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7469,6 +8002,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0xc5, 0x18, 0x6e},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -7489,10 +8023,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf9, 0xeb, 0x3d, 0x4a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -7528,10 +8064,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xf1, 0xf4, 0x3d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fatal Fury Special / Garou Densetsu Special (NGM-058 ~ NGH-058, set 1)",
 		1993,
 		"SNK",
@@ -7561,6 +8099,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9f, 0x0c, 0x1e, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7571,6 +8110,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2d, 0xf0, 0x31, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7581,6 +8121,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0xc5, 0x18, 0x6e},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -7601,10 +8142,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf9, 0xeb, 0x3d, 0x4a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -7640,10 +8183,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xf1, 0xf4, 0x3d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fatal Fury Special / Garou Densetsu Special (NGM-058 ~ NGH-058, set 2)",
 		1993,
 		"SNK",
@@ -7663,6 +8208,7 @@ var mameGames = map[string]struct {
 							[]byte{0x01, 0xd4, 0xe9, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7673,6 +8219,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe0, 0x89, 0x78, 0xca},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7683,6 +8230,7 @@ var mameGames = map[string]struct {
 							[]byte{0x29, 0x99, 0x2e, 0xba},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -7703,10 +8251,12 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x38, 0xc2, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -7752,10 +8302,12 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0x4c, 0xe3, 0xba},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Savage Reign / Fu'un Mokushiroku - Kakutou Sousei",
 		1995,
 		"SNK",
@@ -7775,6 +8327,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x10, 0x4b, 0x50},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7785,6 +8338,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x2a, 0x72, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7795,6 +8349,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x7c, 0x4e, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -7810,10 +8365,12 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0xcf, 0xf7, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -7839,10 +8396,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0x6f, 0x3b, 0x62},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fight Fever / Wang Jung Wang (set 1)",
 		1994,
 		"Viccom",
@@ -7867,6 +8426,7 @@ var mameGames = map[string]struct {
 							[]byte{0x30, 0x32, 0x04, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7877,6 +8437,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x2a, 0x72, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7887,6 +8448,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x7c, 0x4e, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -7902,10 +8464,12 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0xcf, 0xf7, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -7931,10 +8495,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0x6f, 0x3b, 0x62},
 						},
 					},
+					0,
 				},
 			},
 		},
 		fightfeva,
+		"fightfeva",
 		"Fight Fever / Wang Jung Wang (set 2)",
 		1994,
 		"Viccom",
@@ -7954,6 +8520,7 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x69, 0xe0, 0xdc},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -7964,6 +8531,7 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0x6d, 0x1b, 0x68},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -7974,6 +8542,7 @@ var mameGames = map[string]struct {
 							[]byte{0x15, 0x6f, 0x69, 0x51},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -7989,10 +8558,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7c, 0xd6, 0x33, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -8018,10 +8589,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc0, 0xbe, 0x9a, 0x1f},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Super Sidekicks 2 - The World Championship / Tokuten Ou 2 - Real Fight Football (NGM-061 ~ NGH-061)",
 		1994,
 		"SNK",
@@ -8046,6 +8619,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf0, 0x25, 0xab, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8056,6 +8630,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0x9e, 0x2b, 0xbe},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8066,6 +8641,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x10, 0x8b, 0x2f},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -8076,10 +8652,12 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x28, 0x1a, 0xef},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -8125,10 +8703,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x7b, 0xe9, 0x33},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Spin Master / Miracle Adventure",
 		1993,
 		"Data East Corporation",
@@ -8148,6 +8728,7 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0x36, 0x88, 0x92},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8158,6 +8739,7 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0xa5, 0xcd, 0x66},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8168,6 +8750,7 @@ var mameGames = map[string]struct {
 							[]byte{0x56, 0x67, 0x50, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					7340032,
@@ -8193,10 +8776,12 @@ var mameGames = map[string]struct {
 							[]byte{0x24, 0xaa, 0xb4, 0xbb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -8242,10 +8827,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0xfc, 0x6d, 0xfa},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Samurai Shodown II / Shin Samurai Spirits - Haohmaru Jigokuhen (NGM-063 ~ NGH-063)",
 		1994,
 		"SNK",
@@ -8275,6 +8862,7 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0xb1, 0xa4, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8285,6 +8873,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0x08, 0xf8, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8295,6 +8884,7 @@ var mameGames = map[string]struct {
 							[]byte{0x56, 0x67, 0x50, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					7340032,
@@ -8320,10 +8910,12 @@ var mameGames = map[string]struct {
 							[]byte{0x24, 0xaa, 0xb4, 0xbb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -8369,10 +8961,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0xfc, 0x6d, 0xfa},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Saulabi Spirits / Jin Saulabi Tu Hon (Korean release of Samurai Shodown II, set 1)",
 		1994,
 		"SNK",
@@ -8392,6 +8986,7 @@ var mameGames = map[string]struct {
 							[]byte{0x14, 0x7c, 0xc6, 0xd7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8402,6 +8997,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0x08, 0xf8, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8412,6 +9008,7 @@ var mameGames = map[string]struct {
 							[]byte{0x56, 0x67, 0x50, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					7340032,
@@ -8437,10 +9034,12 @@ var mameGames = map[string]struct {
 							[]byte{0x24, 0xaa, 0xb4, 0xbb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -8486,10 +9085,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0xfc, 0x6d, 0xfa},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Saulabi Spirits / Jin Saulabi Tu Hon (Korean release of Samurai Shodown II, set 2)",
 		1994,
 		"SNK",
@@ -8509,6 +9110,7 @@ var mameGames = map[string]struct {
 							[]byte{0x38, 0x5a, 0x2e, 0x86},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8519,6 +9121,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x03, 0x99, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8529,6 +9132,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd2, 0xee, 0xc9, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -8544,10 +9148,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0x52, 0x7e, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -8593,10 +9199,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0x09, 0x23, 0x67},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"World Heroes 2 Jet (ADM-007 ~ ADH-007)",
 		1994,
 		"ADK / SNK",
@@ -8616,6 +9224,7 @@ var mameGames = map[string]struct {
 							[]byte{0x66, 0x92, 0xc1, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8626,6 +9235,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x4b, 0x57, 0x23},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8636,6 +9246,7 @@ var mameGames = map[string]struct {
 							[]byte{0x52, 0xc2, 0x3c, 0xfc},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -8661,10 +9272,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5d, 0xee, 0x79, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -8690,10 +9303,12 @@ var mameGames = map[string]struct {
 							[]byte{0x71, 0x5e, 0x15, 0xff},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Windjammers / Flying Power Disc",
 		1994,
 		"Data East Corporation",
@@ -8713,6 +9328,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8c, 0x86, 0xfd, 0x22},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8723,6 +9339,7 @@ var mameGames = map[string]struct {
 							[]byte{0xba, 0xe5, 0xd5, 0xe5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8733,6 +9350,7 @@ var mameGames = map[string]struct {
 							[]byte{0x03, 0x0b, 0xea, 0xe4},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -8743,10 +9361,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x7e, 0xa3, 0x7a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -8782,10 +9402,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc1, 0x5c, 0x01, 0xed},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Karnov's Revenge / Fighter's History Dynamite",
 		1994,
 		"Data East Corporation",
@@ -8805,6 +9427,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0xea, 0x8a, 0x49},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8815,6 +9438,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0x19, 0xe1, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8825,6 +9449,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9e, 0x3c, 0x63, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -8835,10 +9460,12 @@ var mameGames = map[string]struct {
 							[]byte{0xcf, 0x23, 0xaf, 0xd0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -8854,10 +9481,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0xb6, 0x40, 0x84},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Gururin",
 		1994,
 		"Face",
@@ -8877,6 +9506,7 @@ var mameGames = map[string]struct {
 							[]byte{0x10, 0x5a, 0x40, 0x8f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8887,6 +9517,7 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x08, 0x22, 0x99},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -8897,6 +9528,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0xc7, 0x91, 0x1e},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -8917,10 +9549,12 @@ var mameGames = map[string]struct {
 							[]byte{0x81, 0xff, 0x05, 0xaa},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -8956,10 +9590,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0x72, 0x26, 0x04},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Power Spikes II (NGM-068)",
 		1994,
 		"Video System Co.",
@@ -8984,6 +9620,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdb, 0xe9, 0x63, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -8994,6 +9631,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x33, 0xa8, 0x00},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9004,6 +9642,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0xe7, 0x29, 0x26},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -9024,10 +9663,12 @@ var mameGames = map[string]struct {
 							[]byte{0x58, 0x1c, 0x53, 0x04},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					20971520,
@@ -9063,10 +9704,12 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0x21, 0x04, 0x41},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fatal Fury 3 - Road to the Final Victory / Garou Densetsu 3 - Haruka-naru Tatakai (NGM-069 ~ NGH-069)",
 		1995,
 		"SNK",
@@ -9086,10 +9729,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x96, 0x20, 0x3e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -9100,6 +9745,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x3b, 0x31, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -9110,10 +9756,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd3, 0xa7, 0xe1, 0xff},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -9129,10 +9777,12 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x15, 0x6d, 0xde},
 						},
 					},
+					0,
 				},
 			},
 		},
 		zupapa,
+		"zupapa",
 		"Zupapa!",
 		2001,
 		"SNK",
@@ -9152,6 +9802,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xc3, 0x56, 0xad},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9162,6 +9813,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb8, 0x76, 0xde, 0x7e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9172,6 +9824,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3c, 0xdf, 0x5d, 0x88},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -9187,10 +9840,12 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0x2a, 0xdf, 0xc7},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -9206,10 +9861,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe1, 0x5a, 0x09, 0x3b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Panic Bomber",
 		1994,
 		"Eighting / Hudson",
@@ -9229,6 +9886,7 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0x36, 0x95, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9239,6 +9897,7 @@ var mameGames = map[string]struct {
 							[]byte{0x96, 0x14, 0x8d, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9249,6 +9908,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x52, 0xa9, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -9264,10 +9924,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0xda, 0x86, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -9313,10 +9975,12 @@ var mameGames = map[string]struct {
 							[]byte{0x14, 0xe7, 0xad, 0x71},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Aggressors of Dark Kombat / Tsuukai GANGAN Koushinkyoku (ADM-008 ~ ADH-008)",
 		1994,
 		"ADK / SNK",
@@ -9336,6 +10000,7 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0x87, 0x17, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9346,6 +10011,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc9, 0xee, 0xc3, 0x67},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9356,6 +10022,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbb, 0x82, 0x8d, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -9371,10 +10038,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0x17, 0x60, 0xcd},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -9400,10 +10069,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0x77, 0x72, 0x06},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Aero Fighters 2 / Sonic Wings 2",
 		1994,
 		"Video System Co.",
@@ -9423,6 +10094,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd7, 0xc1, 0xef, 0xfd},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9433,6 +10105,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0xc2, 0x5d, 0xd5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9443,6 +10116,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0x5f, 0x3d, 0x0a},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -9463,10 +10137,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb9, 0x06, 0x58, 0xfa},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -9492,10 +10168,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x1c, 0x08, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Zed Blade / Operation Ragnarok",
 		1994,
 		"NMK",
@@ -9515,6 +10193,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x90, 0x63, 0x09},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9525,6 +10204,7 @@ var mameGames = map[string]struct {
 							[]byte{0x72, 0xf8, 0x92, 0x3e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9535,6 +10215,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x9e, 0x3b, 0x10},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -9555,10 +10236,12 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0xbc, 0xa6, 0x56},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					14680064,
@@ -9604,10 +10287,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x7c, 0xc0, 0xd8},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Galaxy Fight - Universal Warriors",
 		1995,
 		"Sunsoft",
@@ -9627,6 +10312,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x78, 0x32, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9637,6 +10323,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3a, 0xc0, 0x66, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9647,6 +10334,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0xe3, 0x45, 0x5a},
 						},
 					},
+					0,
 				},
 				{
 					3145728,
@@ -9662,10 +10350,12 @@ var mameGames = map[string]struct {
 							[]byte{0x72, 0x07, 0x74, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -9691,10 +10381,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa4, 0xc9, 0x02, 0x13},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Street Hoop / Street Slam / Dunk Dream (DEM-004 ~ DEH-004)",
 		1994,
 		"Data East Corporation",
@@ -9714,6 +10406,7 @@ var mameGames = map[string]struct {
 							[]byte{0x44, 0x40, 0x31, 0x5e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9724,6 +10417,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd7, 0xb8, 0x61, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9734,6 +10428,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf5, 0xf4, 0x41, 0x72},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -9754,10 +10449,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf0, 0x28, 0x44, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -9783,10 +10480,12 @@ var mameGames = map[string]struct {
 							[]byte{0xca, 0x6f, 0x54, 0x60},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Quiz King of Fighters (SAM-080 ~ SAH-080)",
 		1995,
 		"Saurus (SNK license)",
@@ -9806,6 +10505,7 @@ var mameGames = map[string]struct {
 							[]byte{0x25, 0x89, 0x48, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9816,6 +10516,7 @@ var mameGames = map[string]struct {
 							[]byte{0xaf, 0x72, 0xc3, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9826,6 +10527,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0x15, 0x7e, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -9846,10 +10548,12 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0xb7, 0xef, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -9875,10 +10579,12 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0xe5, 0xd9, 0x97},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Quiz King of Fighters (Korea)",
 		1996,
 		"Saurus / Viccom (SNK license)",
@@ -9898,6 +10604,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6b, 0xc2, 0x7a, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -9908,6 +10615,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x26, 0xda, 0x34},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -9918,6 +10626,7 @@ var mameGames = map[string]struct {
 							[]byte{0x82, 0xfc, 0xd8, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -9938,10 +10647,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe5, 0x24, 0xe4, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -9977,10 +10688,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6b, 0x53, 0xfb, 0x75},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Super Sidekicks 3 - The Next Glory / Tokuten Ou 3 - Eikou e no Michi",
 		1995,
 		"SNK",
@@ -10000,6 +10713,7 @@ var mameGames = map[string]struct {
 							[]byte{0x34, 0xab, 0x83, 0x2a},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10010,6 +10724,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0xf9, 0x95, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10020,6 +10735,7 @@ var mameGames = map[string]struct {
 							[]byte{0x10, 0xb1, 0x44, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -10035,10 +10751,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc3, 0xff, 0x55, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					14680064,
@@ -10084,10 +10802,12 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0xa5, 0xfa, 0x37},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Double Dragon (Neo-Geo)",
 		1995,
 		"Technos Japan",
@@ -10107,6 +10827,7 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0x02, 0xca, 0x14},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10117,6 +10838,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9c, 0xaa, 0xe5, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10127,6 +10849,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0x24, 0x36, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					3670016,
@@ -10152,10 +10875,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x54, 0x89, 0x48},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					5242880,
@@ -10191,10 +10916,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x42, 0xd7, 0xeb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof95a,
+		"kof95a",
 		"Puzzle Bobble / Bust-A-Move (Neo-Geo, NGM-083)",
 		1994,
 		"Taito",
@@ -10214,6 +10941,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2c, 0xba, 0x27, 0x16},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10224,6 +10952,7 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0x71, 0x6f, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10234,6 +10963,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x2d, 0x74, 0x29},
 						},
 					},
+					0,
 				},
 				{
 					9437184,
@@ -10254,10 +10984,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x83, 0xa3, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10303,10 +11035,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0xeb, 0x0f, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '95 (NGM-084)",
 		1995,
 		"SNK",
@@ -10341,6 +11075,7 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0xd3, 0xfb, 0xee},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10351,6 +11086,7 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0x71, 0x6f, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10361,6 +11097,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x2d, 0x74, 0x29},
 						},
 					},
+					0,
 				},
 				{
 					9437184,
@@ -10381,10 +11118,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x83, 0xa3, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10430,10 +11169,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0xeb, 0x0f, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof95a,
+		"kof95a",
 		"The King of Fighters '95 (NGM-084, alt board)",
 		1995,
 		"SNK",
@@ -10453,6 +11194,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x54, 0xcf, 0x95},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10463,6 +11205,7 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0x71, 0x6f, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10473,6 +11216,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x2d, 0x74, 0x29},
 						},
 					},
+					0,
 				},
 				{
 					9437184,
@@ -10493,10 +11237,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0x83, 0xa3, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10542,10 +11288,12 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0xeb, 0x0f, 0x9b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '95 (NGH-084)",
 		1995,
 		"SNK",
@@ -10565,6 +11313,7 @@ var mameGames = map[string]struct {
 							[]byte{0x03, 0xe2, 0x0a, 0xb6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10575,6 +11324,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x5e, 0x2b, 0x3a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10585,6 +11335,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcb, 0x82, 0xbc, 0x5d},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -10600,10 +11351,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb7, 0xeb, 0x05, 0xdf},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -10629,10 +11382,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0x6e, 0x7d, 0x82},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Tecmo World Soccer '96",
 		1996,
 		"Tecmo",
@@ -10672,6 +11427,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe8, 0x6c, 0xa4, 0xaf},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10682,6 +11438,7 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0xec, 0x7d, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10692,6 +11449,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x64, 0x40, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -10707,10 +11465,12 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x0f, 0x26, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10756,10 +11516,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0xe8, 0x27, 0x17},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof95a,
+		"kof95a",
 		"Samurai Shodown III / Samurai Spirits - Zankurou Musouken (NGM-087)",
 		1995,
 		"SNK",
@@ -10784,6 +11546,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0xbe, 0x27, 0xe0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10794,6 +11557,7 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0xec, 0x7d, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10804,6 +11568,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x64, 0x40, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -10819,10 +11584,12 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x0f, 0x26, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10868,10 +11635,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0xe8, 0x27, 0x17},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Samurai Shodown III / Samurai Spirits - Zankurou Musouken (NGH-087)",
 		1995,
 		"SNK",
@@ -10896,6 +11665,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0xbe, 0x27, 0xe0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -10906,6 +11676,7 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0xec, 0x7d, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -10916,6 +11687,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x64, 0x40, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -10931,10 +11703,12 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x0f, 0x26, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					27262976,
@@ -10980,10 +11754,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0xe8, 0x27, 0x17},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Fighters Swords (Korean release of Samurai Shodown III)",
 		1995,
 		"SNK",
@@ -11003,6 +11779,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbd, 0x58, 0x14, 0xf6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11013,6 +11790,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x3c, 0xb2, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11023,6 +11801,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2f, 0xe1, 0xf4, 0x99},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -11033,10 +11812,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb7, 0x78, 0x50, 0x23},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -11062,10 +11843,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x04, 0xf0, 0xdc},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Stakes Winner / Stakes Winner - GI Kinzen Seiha e no Michi",
 		1995,
 		"Saurus",
@@ -11090,6 +11873,7 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0x8b, 0x77, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11100,6 +11884,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0x9f, 0xc2, 0xc8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11110,6 +11895,7 @@ var mameGames = map[string]struct {
 							[]byte{0xff, 0x3d, 0xf7, 0xc7},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -11125,10 +11911,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0x2d, 0xb5, 0x51},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -11174,10 +11962,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa2, 0x23, 0x57, 0x2d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Pulstar",
 		1995,
 		"Aicom",
@@ -11197,6 +11987,7 @@ var mameGames = map[string]struct {
 							[]byte{0xaf, 0xaa, 0x47, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11207,6 +11998,7 @@ var mameGames = map[string]struct {
 							[]byte{0x17, 0x4a, 0x88, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11217,6 +12009,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0x06, 0x56, 0x68},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -11237,10 +12030,12 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0x08, 0xa7, 0xce},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -11286,10 +12081,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0x09, 0x23, 0x67},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"World Heroes Perfect",
 		1995,
 		"ADK / SNK",
@@ -11309,6 +12106,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0xec, 0x9b, 0x77},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11319,6 +12117,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa3, 0xd6, 0x8e, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11329,6 +12128,7 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x95, 0x7e, 0xf6},
 						},
 					},
+					0,
 				},
 				{
 					7340032,
@@ -11354,10 +12154,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0xc9, 0xc9, 0x49},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -11383,10 +12185,12 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0x07, 0xf9, 0x97},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Far East of Eden - Kabuki Klash / Tengai Makyou - Shin Den",
 		1995,
 		"Hudson",
@@ -11406,6 +12210,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa1, 0xa7, 0x1d, 0x0d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11416,6 +12221,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x3f, 0xa1, 0x19},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11426,6 +12232,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe8, 0x1e, 0x78, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -11441,10 +12248,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0x2b, 0x8b, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					9437184,
@@ -11470,10 +12279,12 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x82, 0x67, 0x83},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Neo Bomberman",
 		1997,
 		"Hudson",
@@ -11493,6 +12304,7 @@ var mameGames = map[string]struct {
 							[]byte{0x33, 0x01, 0x95, 0x45},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11503,6 +12315,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2f, 0x87, 0x48, 0xa2},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11513,6 +12326,7 @@ var mameGames = map[string]struct {
 							[]byte{0x78, 0xc8, 0x51, 0xcb},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -11533,10 +12347,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc6, 0x3b, 0x92, 0x85},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -11582,10 +12398,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd8, 0x0d, 0xd2, 0x41},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Voltage Fighter - Gowcaizer / Choujin Gakuen Gowcaizer",
 		1995,
 		"Technos Japan",
@@ -11610,6 +12428,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x15, 0x82, 0x6e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11620,6 +12439,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0xbf, 0x5e, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11630,6 +12450,7 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0x34, 0x92, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -11650,10 +12471,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x9d, 0x1c, 0x6c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -11699,10 +12522,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x6b, 0xeb, 0x6c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury / Real Bout Garou Densetsu (NGM-095 ~ NGH-095)",
 		1995,
 		"SNK",
@@ -11732,6 +12557,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0x00, 0x60, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11742,6 +12568,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0xbf, 0x5e, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11752,6 +12579,7 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0x34, 0x92, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -11772,10 +12600,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x9d, 0x1c, 0x6c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -11821,10 +12651,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x6b, 0xeb, 0x6c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury / Real Bout Garou Densetsu (bug fix revision)",
 		1995,
 		"SNK",
@@ -11854,6 +12686,7 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x20, 0x93, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11864,6 +12697,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0xbf, 0x5e, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11874,6 +12708,7 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0x34, 0x92, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -11894,10 +12729,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x9d, 0x1c, 0x6c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -11943,10 +12780,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x6b, 0xeb, 0x6c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury / Real Bout Garou Densetsu (Korean release, bug fix revision)",
 		1995,
 		"SNK",
@@ -11971,6 +12810,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x15, 0x82, 0x6e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -11981,6 +12821,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0xbf, 0x5e, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -11991,6 +12832,7 @@ var mameGames = map[string]struct {
 							[]byte{0x65, 0x34, 0x92, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -12011,10 +12853,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x9d, 0x1c, 0x6c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -12060,10 +12904,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x6b, 0xeb, 0x6c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury / Real Bout Garou Densetsu (Korean release)",
 		1995,
 		"SNK",
@@ -12088,6 +12934,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x5a, 0x26, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12098,6 +12945,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x7f, 0xd3, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12108,6 +12956,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcb, 0x07, 0xb6, 0x59},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -12128,10 +12977,12 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0x9d, 0x12, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -12177,10 +13028,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9a, 0x34, 0xf9, 0x9c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Art of Fighting 3 - The Path of the Warrior / Art of Fighting - Ryuuko no Ken Gaiden",
 		1996,
 		"SNK",
@@ -12205,6 +13058,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x5a, 0x26, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12215,6 +13069,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcc, 0x7f, 0xd3, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12225,6 +13080,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcb, 0x07, 0xb6, 0x59},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -12245,10 +13101,12 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0x9d, 0x12, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -12294,10 +13152,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9a, 0x34, 0xf9, 0x9c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Art of Fighting 3 - The Path of the Warrior (Korean release)",
 		1996,
 		"SNK",
@@ -12317,6 +13177,7 @@ var mameGames = map[string]struct {
 							[]byte{0x05, 0x47, 0x12, 0x1d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12327,6 +13188,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0xd6, 0x67, 0x43},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12337,6 +13199,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb2, 0x0e, 0x42, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -12352,10 +13215,12 @@ var mameGames = map[string]struct {
 							[]byte{0x33, 0x59, 0xe8, 0x68},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -12381,10 +13246,12 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0xa4, 0x0c, 0x6e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Aero Fighters 3 / Sonic Wings 3",
 		1995,
 		"Video System Co.",
@@ -12404,6 +13271,7 @@ var mameGames = map[string]struct {
 							[]byte{0x28, 0xc8, 0x30, 0x48},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12414,6 +13282,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9a, 0x54, 0x02, 0xb2},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12424,6 +13293,7 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x94, 0xac, 0x00},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -12449,10 +13319,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6c, 0x7b, 0x49, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -12468,10 +13340,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x65, 0xa8, 0xce},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Neo Turf Masters / Big Tournament Golf",
 		1996,
 		"Nazca",
@@ -12491,6 +13365,7 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0xd8, 0xda, 0xa5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12501,6 +13376,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2f, 0x55, 0x95, 0x8d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12511,6 +13387,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc2, 0x8b, 0x32, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -12526,10 +13403,12 @@ var mameGames = map[string]struct {
 							[]byte{0x47, 0x2c, 0xf9, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -12555,10 +13434,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0xad, 0x59, 0xa3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Metal Slug - Super Vehicle-001",
 		1996,
 		"Nazca",
@@ -12578,6 +13459,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0x61, 0x41, 0x5b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12588,6 +13470,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0x19, 0x26, 0x4f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12598,6 +13481,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9c, 0x02, 0x91, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -12608,10 +13492,12 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0xbe, 0xb8, 0xfb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -12627,10 +13513,12 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x37, 0x13, 0x07},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Puzzle De Pon!",
 		1995,
 		"Visco",
@@ -12650,6 +13538,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0xa7, 0x0e, 0x2d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12660,6 +13549,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbf, 0xdc, 0x83, 0x09},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12670,6 +13560,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa6, 0x02, 0xc2, 0xc2},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -12680,10 +13571,12 @@ var mameGames = map[string]struct {
 							[]byte{0xba, 0xa2, 0xb9, 0xa5},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -12699,10 +13592,12 @@ var mameGames = map[string]struct {
 							[]byte{0x25, 0x74, 0xbe, 0x03},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Shougi no Tatsujin - Master of Shougi",
 		1990,
 		"ADK / SNK",
@@ -12722,6 +13617,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc3, 0x3e, 0xd2, 0x1e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12732,6 +13628,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf0, 0xb6, 0x87, 0x80},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12742,6 +13639,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x22, 0x90, 0x2e},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -12757,10 +13655,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf8, 0xc5, 0x54, 0x04},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -12786,10 +13686,12 @@ var mameGames = map[string]struct {
 							[]byte{0x55, 0xe1, 0x31, 0x4d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Chibi Marukochan Deluxe Quiz",
 		1995,
 		"Takara",
@@ -12809,6 +13711,7 @@ var mameGames = map[string]struct {
 							[]byte{0x33, 0x4e, 0xa5, 0x1e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12819,6 +13722,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6a, 0xeb, 0xaf, 0xce},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12829,6 +13733,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb5, 0xb7, 0x4a, 0x95},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -12839,10 +13744,12 @@ var mameGames = map[string]struct {
 							[]byte{0x41, 0x43, 0xc0, 0x52},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -12858,10 +13765,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf5, 0x71, 0x66, 0xd2},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Neo Mr. Do!",
 		1996,
 		"Visco",
@@ -12881,6 +13790,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0x7f, 0x3d, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12891,6 +13801,7 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0xab, 0xd6, 0xb3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12901,6 +13812,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x5f, 0x33, 0x25},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -12911,10 +13823,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0x89, 0x9a, 0x24},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -12940,10 +13854,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0x16, 0x5f, 0x19},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Super Dodge Ball / Kunio no Nekketsu Toukyuu Densetsu",
 		1996,
 		"Technos Japan",
@@ -12963,6 +13879,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x01, 0x9a, 0x79},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -12973,6 +13890,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc0, 0xea, 0xad, 0x86},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -12983,6 +13901,7 @@ var mameGames = map[string]struct {
 							[]byte{0xcd, 0x75, 0x83, 0x25},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -12993,10 +13912,12 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0x21, 0x42, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -13022,10 +13943,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1e, 0x9f, 0x76, 0xf2},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Goal! Goal! Goal!",
 		1995,
 		"Visco",
@@ -13045,6 +13968,7 @@ var mameGames = map[string]struct {
 							[]byte{0x16, 0xc0, 0x63, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13055,6 +13979,7 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0x1d, 0x3d, 0xdc},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13065,6 +13990,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfc, 0xab, 0x61, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -13075,10 +14001,12 @@ var mameGames = map[string]struct {
 							[]byte{0x01, 0x3d, 0x4e, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					20971520,
@@ -13114,10 +14042,12 @@ var mameGames = map[string]struct {
 							[]byte{0x05, 0x89, 0xc1, 0x5e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Over Top",
 		1996,
 		"ADK",
@@ -13137,6 +14067,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe3, 0x97, 0xd7, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13147,6 +14078,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb7, 0x6b, 0x61, 0xbc},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13157,6 +14089,7 @@ var mameGames = map[string]struct {
 							[]byte{0x20, 0x00, 0x45, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -13172,10 +14105,12 @@ var mameGames = map[string]struct {
 							[]byte{0x23, 0x3c, 0x7d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -13191,10 +14126,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0xae, 0x5f, 0x16},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Neo Drift Out - New Technology",
 		1996,
 		"Visco",
@@ -13219,6 +14156,7 @@ var mameGames = map[string]struct {
 							[]byte{0x00, 0x2c, 0xcb, 0x73},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13229,6 +14167,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0x54, 0xcb, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13239,6 +14178,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0xbc, 0x42, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -13259,10 +14199,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0xa2, 0x25, 0x7d},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -13308,10 +14250,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0x14, 0x61, 0xad},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '96 (NGM-214)",
 		1996,
 		"SNK",
@@ -13336,6 +14280,7 @@ var mameGames = map[string]struct {
 							[]byte{0x00, 0x2c, 0xcb, 0x73},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13346,6 +14291,7 @@ var mameGames = map[string]struct {
 							[]byte{0x12, 0x54, 0xcb, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13356,6 +14302,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0xbc, 0x42, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -13376,10 +14323,12 @@ var mameGames = map[string]struct {
 							[]byte{0x92, 0xa2, 0x25, 0x7d},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -13425,10 +14374,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0x14, 0x61, 0xad},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '96 (NGH-214)",
 		1996,
 		"SNK",
@@ -13448,6 +14399,7 @@ var mameGames = map[string]struct {
 							[]byte{0x51, 0x9b, 0x4b, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13458,6 +14410,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf0, 0xfe, 0x5c, 0x36},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13468,6 +14421,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0x32, 0x08, 0x1d},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -13483,10 +14437,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0xfa, 0x21, 0x8b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					20971520,
@@ -13522,10 +14478,12 @@ var mameGames = map[string]struct {
 							[]byte{0x32, 0x9c, 0x5e, 0x1b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Ultimate 11 - The SNK Football Championship / Tokuten Ou - Honoo no Libero",
 		1996,
 		"SNK",
@@ -13545,6 +14503,7 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0xd2, 0xb3, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13555,6 +14514,7 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0xdc, 0x72, 0xd7},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13565,6 +14525,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0x09, 0x68, 0x20},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -13590,10 +14551,12 @@ var mameGames = map[string]struct {
 							[]byte{0x31, 0xb9, 0x9b, 0xd6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					29360128,
@@ -13639,10 +14602,12 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0x4c, 0xe3, 0xba},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Kizuna Encounter - Super Tag Battle / Fu'un Super Tag Battle",
 		1996,
 		"SNK",
@@ -13667,6 +14632,7 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0x1f, 0xca, 0x88},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13677,6 +14643,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0xf7, 0x82, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13687,6 +14654,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0x0f, 0xb2, 0xaf},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -13702,10 +14670,12 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0xf1, 0xc6, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -13751,10 +14721,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa0, 0x85, 0xbb, 0x61},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ninja Master's - Haoh-ninpo-cho",
 		1996,
 		"ADK / SNK",
@@ -13774,6 +14746,7 @@ var mameGames = map[string]struct {
 							[]byte{0xca, 0x37, 0x23, 0x03},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13784,6 +14757,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x40, 0x2f, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13794,6 +14768,7 @@ var mameGames = map[string]struct {
 							[]byte{0x17, 0x02, 0x8b, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -13809,10 +14784,12 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0x04, 0xe2, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -13858,10 +14835,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd9, 0xb3, 0x11, 0xf6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ragnagard / Shin-Oh-Ken",
 		1996,
 		"Saurus",
@@ -13881,6 +14860,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6a, 0xf0, 0xe5, 0x74},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13891,6 +14871,7 @@ var mameGames = map[string]struct {
 							[]byte{0x00, 0x2f, 0x3c, 0x88},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13901,6 +14882,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x8e, 0xfd, 0xc8},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -13911,10 +14893,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0xae, 0x33, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -13940,10 +14924,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf5, 0x7b, 0x4a, 0x1c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Pleasure Goal / Futsal - 5 on 5 Mini Soccer (NGM-219)",
 		1996,
 		"Saurus",
@@ -13963,6 +14949,7 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0xa9, 0x42, 0xc6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -13973,6 +14960,7 @@ var mameGames = map[string]struct {
 							[]byte{0x37, 0x2f, 0xe2, 0x17},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -13983,6 +14971,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3a, 0x08, 0xbb, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -13993,10 +14982,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0x30, 0xa2, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -14022,10 +15013,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0x3e, 0xa3, 0x8b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Choutetsu Brikin'ger / Iron Clad (Prototype)",
 		1996,
 		"Saurus",
@@ -14045,6 +15038,7 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0x37, 0xe3, 0xa0},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14055,6 +15049,7 @@ var mameGames = map[string]struct {
 							[]byte{0x37, 0x2f, 0xe2, 0x17},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14065,6 +15060,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3a, 0x08, 0xbb, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -14075,10 +15071,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8f, 0x30, 0xa2, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -14104,10 +15102,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0x3e, 0xa3, 0x8b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Choutetsu Brikin'ger / Iron Clad (Prototype, bootleg)",
 		1996,
 		"bootleg",
@@ -14127,6 +15127,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7b, 0xe8, 0x23, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14137,6 +15138,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x40, 0x63, 0xa3},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14147,6 +15149,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbd, 0xda, 0xe6, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -14157,10 +15160,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0x5e, 0x53, 0xe4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -14176,10 +15181,12 @@ var mameGames = map[string]struct {
 							[]byte{0x14, 0xb9, 0x05, 0x36},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Magical Drop II",
 		1996,
 		"Data East Corporation",
@@ -14204,6 +15211,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb0, 0x23, 0xcd, 0x8b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14214,6 +15222,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x3d, 0x3b, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14224,6 +15233,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x15, 0xbc, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -14244,10 +15254,12 @@ var mameGames = map[string]struct {
 							[]byte{0x83, 0x1e, 0xa8, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -14293,10 +15305,12 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xfc, 0x50, 0xe3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Samurai Shodown IV - Amakusa's Revenge / Samurai Spirits - Amakusa Kourin (NGM-222 ~ NGH-222)",
 		1996,
 		"SNK",
@@ -14321,6 +15335,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb0, 0x23, 0xcd, 0x8b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14331,6 +15346,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd3, 0x13, 0x68, 0x7d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14341,6 +15357,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x15, 0xbc, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -14361,10 +15378,12 @@ var mameGames = map[string]struct {
 							[]byte{0x83, 0x1e, 0xa8, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -14410,10 +15429,12 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xfc, 0x50, 0xe3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Pae Wang Jeon Seol / Legend of a Warrior (Korean censored Samurai Shodown IV)",
 		1996,
 		"SNK",
@@ -14438,6 +15459,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xdd, 0x8f, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14448,6 +15470,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0xcd, 0x6e, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14458,6 +15481,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3f, 0xee, 0x46, 0xbf},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -14478,10 +15502,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x79, 0x7f, 0xd2},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -14527,10 +15553,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0xd6, 0x21, 0x02},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury Special / Real Bout Garou Densetsu Special",
 		1996,
 		"SNK",
@@ -14555,6 +15583,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xdd, 0x8f, 0x08},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14565,6 +15594,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0xcd, 0x6e, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14575,6 +15605,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3f, 0xee, 0x46, 0xbf},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -14595,10 +15626,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0x79, 0x7f, 0xd2},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -14644,10 +15677,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5a, 0xd6, 0x21, 0x02},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury Special / Real Bout Garou Densetsu Special (Korean release)",
 		1996,
 		"SNK",
@@ -14667,6 +15702,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x97, 0xe4, 0x45},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14677,6 +15713,7 @@ var mameGames = map[string]struct {
 							[]byte{0xee, 0xed, 0x57, 0x58},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14687,6 +15724,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0x4d, 0x6f, 0x96},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -14702,10 +15740,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7a, 0xd2, 0x65, 0x99},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -14731,10 +15771,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb5, 0x53, 0x2e, 0x53},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Twinkle Star Sprites",
 		1996,
 		"ADK / SNK",
@@ -14759,6 +15801,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfe, 0x19, 0x06, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14769,6 +15812,7 @@ var mameGames = map[string]struct {
 							[]byte{0x71, 0xc4, 0xb4, 0xb5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14779,6 +15823,7 @@ var mameGames = map[string]struct {
 							[]byte{0x06, 0x34, 0xbb, 0xa6},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -14794,10 +15839,12 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0x59, 0xc5, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					25165824,
@@ -14833,10 +15880,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0xb1, 0x1a, 0x6d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Waku Waku 7",
 		1996,
 		"Sunsoft",
@@ -14856,6 +15905,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0xf1, 0x01, 0xd2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14866,6 +15916,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x8c, 0x44, 0x62},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14876,6 +15927,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0xe5, 0xe0, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -14891,10 +15943,12 @@ var mameGames = map[string]struct {
 							[]byte{0xee, 0x39, 0xe2, 0x60},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					12582912,
@@ -14920,10 +15974,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0xfe, 0xa4, 0x3a},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Stakes Winner 2",
 		1996,
 		"Saurus",
@@ -14943,6 +15999,7 @@ var mameGames = map[string]struct {
 							[]byte{0x60, 0x33, 0x17, 0x2e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -14953,6 +16010,7 @@ var mameGames = map[string]struct {
 							[]byte{0x83, 0xc2, 0x4e, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -14963,6 +16021,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfd, 0x83, 0x3b, 0x33},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -14973,10 +16032,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc6, 0x03, 0xfc, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -14992,10 +16053,12 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0x78, 0x80, 0x82},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Ghostlop (prototype)",
 		1996,
 		"Data East Corporation",
@@ -15015,6 +16078,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x24, 0xa6, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15025,6 +16089,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x6f, 0xb6, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15035,6 +16100,7 @@ var mameGames = map[string]struct {
 							[]byte{0x39, 0x51, 0xa1, 0xc1},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -15050,10 +16116,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1d, 0x43, 0xe4, 0x20},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -15079,10 +16147,12 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xae, 0xb7, 0x4c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Breakers",
 		1996,
 		"Visco",
@@ -15102,6 +16172,7 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0xbe, 0x18, 0x10},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15112,6 +16183,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfe, 0x0c, 0x0c, 0x53},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15122,6 +16194,7 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0x41, 0x30, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -15132,10 +16205,12 @@ var mameGames = map[string]struct {
 							[]byte{0x11, 0x3f, 0xb8, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					6291456,
@@ -15161,10 +16236,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2e, 0x35, 0xe7, 0x1b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Money Puzzle Exchanger / Money Idol Exchanger",
 		1997,
 		"Face",
@@ -15189,6 +16266,7 @@ var mameGames = map[string]struct {
 							[]byte{0x15, 0x8b, 0x23, 0xf6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15199,6 +16277,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x14, 0xec, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15209,6 +16288,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x34, 0x87, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -15229,10 +16309,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x9e, 0xb9, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15268,10 +16350,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0xf4, 0xd4, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '97 (NGM-2320)",
 		1997,
 		"SNK",
@@ -15296,6 +16380,7 @@ var mameGames = map[string]struct {
 							[]byte{0x15, 0x8b, 0x23, 0xf6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15306,6 +16391,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x14, 0xec, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15316,6 +16402,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x34, 0x87, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -15336,10 +16423,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x9e, 0xb9, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15375,10 +16464,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0xf4, 0xd4, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '97 (NGH-2320)",
 		1997,
 		"SNK",
@@ -15403,6 +16494,7 @@ var mameGames = map[string]struct {
 							[]byte{0x15, 0x8b, 0x23, 0xf6},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15413,6 +16505,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x14, 0xec, 0xf5},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15423,6 +16516,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbb, 0xea, 0x90, 0x70},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -15443,10 +16537,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x9e, 0xb9, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15482,10 +16578,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0xf4, 0xd4, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '97 (Korean release)",
 		1997,
 		"SNK",
@@ -15505,6 +16603,7 @@ var mameGames = map[string]struct {
 							[]byte{0x93, 0x1e, 0x17, 0xfa},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15515,6 +16614,7 @@ var mameGames = map[string]struct {
 							[]byte{0x73, 0x99, 0xe6, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15525,6 +16625,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xea, 0xf3, 0x4e},
 						},
 					},
+					0,
 				},
 				{
 					4718592,
@@ -15540,10 +16641,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd5, 0xe3, 0x0d, 0xf4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -15569,10 +16672,12 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0xdb, 0xbd, 0x6d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Magical Drop III",
 		1997,
 		"Data East Corporation",
@@ -15597,6 +16702,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0f, 0xdc, 0x28, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15607,6 +16713,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x56, 0x14, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15617,6 +16724,7 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0x76, 0x28, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -15642,10 +16750,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x34, 0x15, 0x7f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15681,10 +16791,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0xaf, 0xd0, 0x91},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Last Blade / Bakumatsu Roman - Gekka no Kenshi (NGM-2340)",
 		1997,
 		"SNK",
@@ -15709,6 +16821,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0f, 0xdc, 0x28, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15719,6 +16832,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x56, 0x14, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15729,6 +16843,7 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0x76, 0x28, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -15754,10 +16869,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x34, 0x15, 0x7f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15793,10 +16910,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0xaf, 0xd0, 0x91},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Last Blade / Bakumatsu Roman - Gekka no Kenshi (NGH-2340)",
 		1997,
 		"SNK",
@@ -15821,6 +16940,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0f, 0xdc, 0x28, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15831,6 +16951,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x56, 0x14, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15841,6 +16962,7 @@ var mameGames = map[string]struct {
 							[]byte{0x08, 0x76, 0x28, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -15866,10 +16988,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x34, 0x15, 0x7f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -15905,10 +17029,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0xaf, 0xd0, 0x91},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Last Soldier (Korean release of The Last Blade)",
 		1997,
 		"SNK",
@@ -15928,6 +17054,7 @@ var mameGames = map[string]struct {
 							[]byte{0xaf, 0xed, 0x5d, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -15938,6 +17065,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3b, 0x13, 0xa2, 0x2f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -15948,6 +17076,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9c, 0x02, 0x91, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -15958,10 +17087,12 @@ var mameGames = map[string]struct {
 							[]byte{0xde, 0xbe, 0xb8, 0xfb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
@@ -15977,10 +17108,12 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x37, 0x13, 0x07},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Puzzle De Pon! R!",
 		1997,
 		"Visco",
@@ -16000,6 +17133,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbe, 0x96, 0xe4, 0x4f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16010,6 +17144,7 @@ var mameGames = map[string]struct {
 							[]byte{0xb6, 0x1c, 0xf5, 0x95},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -16020,6 +17155,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0xc9, 0x46, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -16030,10 +17166,12 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xcb, 0x1b, 0xeb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -16049,10 +17187,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x63, 0x3c, 0x65},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Pop 'n Bounce / Gapporin",
 		1997,
 		"Video System Co.",
@@ -16077,6 +17217,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0x4a, 0x09, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16087,6 +17228,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0x95, 0xce, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -16097,6 +17239,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x5b, 0x95, 0x18},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -16112,10 +17255,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0xd7, 0xd5, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -16161,10 +17306,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x7c, 0x2e, 0xfb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Shock Troopers (set 1)",
 		1997,
 		"Saurus",
@@ -16189,6 +17336,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0x4a, 0x09, 0xc5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16199,6 +17347,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0x95, 0xce, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -16209,6 +17358,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0x5b, 0x95, 0x18},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -16224,10 +17374,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0xd7, 0xd5, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -16273,10 +17425,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1c, 0x7c, 0x2e, 0xfb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Shock Troopers (set 2)",
 		1997,
 		"Saurus",
@@ -16301,6 +17455,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9a, 0x9f, 0x41, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16311,6 +17466,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd5, 0x6c, 0xb4, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -16321,6 +17477,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd3, 0x1a, 0x3a, 0xea},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -16336,10 +17493,12 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0xcf, 0x0a, 0x70},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -16385,10 +17544,12 @@ var mameGames = map[string]struct {
 							[]byte{0xdb, 0x60, 0x46, 0x0e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Blazing Star",
 		1998,
 		"Yumekobo",
@@ -16413,6 +17574,7 @@ var mameGames = map[string]struct {
 							[]byte{0x96, 0x0a, 0xa8, 0x8d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16423,6 +17585,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x3b, 0x40, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16433,6 +17596,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x48, 0x27, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -16458,10 +17622,12 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x67, 0x04, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -16497,10 +17663,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x09, 0xe5, 0x07},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury 2 - The Newcomers / Real Bout Garou Densetsu 2 - The Newcomers (NGM-2400)",
 		1998,
 		"SNK",
@@ -16525,6 +17693,7 @@ var mameGames = map[string]struct {
 							[]byte{0x96, 0x0a, 0xa8, 0x8d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16535,6 +17704,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x3b, 0x40, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16545,6 +17715,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x48, 0x27, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -16570,10 +17741,12 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x67, 0x04, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -16609,10 +17782,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x09, 0xe5, 0x07},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury 2 - The Newcomers / Real Bout Garou Densetsu 2 - The Newcomers (NGH-2400)",
 		1998,
 		"SNK",
@@ -16637,6 +17812,7 @@ var mameGames = map[string]struct {
 							[]byte{0x96, 0x0a, 0xa8, 0x8d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16647,6 +17823,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x3b, 0x40, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16657,6 +17834,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0x48, 0x27, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -16682,10 +17860,12 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x67, 0x04, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -16721,10 +17901,12 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0x09, 0xe5, 0x07},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Real Bout Fatal Fury 2 - The Newcomers (Korean release)",
 		1998,
 		"SNK",
@@ -16749,6 +17931,7 @@ var mameGames = map[string]struct {
 							[]byte{0x38, 0x88, 0x3f, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16759,6 +17942,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0xd3, 0x2f, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -16769,6 +17953,7 @@ var mameGames = map[string]struct {
 							[]byte{0x94, 0x52, 0x0e, 0xbd},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -16784,10 +17969,12 @@ var mameGames = map[string]struct {
 							[]byte{0xec, 0xb1, 0x67, 0x99},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -16813,10 +18000,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x3e, 0x30, 0x6f},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Metal Slug 2 - Super Vehicle-001/II (NGM-2410 ~ NGH-2410)",
 		1998,
 		"SNK",
@@ -16841,6 +18030,7 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0x0a, 0xba, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16851,6 +18041,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7f, 0x7b, 0x48, 0x05},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16861,6 +18052,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0xf7, 0x01, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -16886,10 +18078,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x85, 0xea, 0x30},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -16935,10 +18129,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x23, 0xe0, 0x45},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof98,
+		"kof98",
 		"The King of Fighters '98 - The Slugfest / King of Fighters '98 - Dream Match Never Ends (NGM-2420)",
 		1998,
 		"SNK",
@@ -16968,6 +18164,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6e, 0x47, 0x48, 0x41},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16978,6 +18175,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7f, 0x7b, 0x48, 0x05},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -16988,6 +18186,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0xf7, 0x01, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17013,10 +18212,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x85, 0xea, 0x30},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -17062,10 +18263,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x23, 0xe0, 0x45},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof98,
+		"kof98",
 		"The King of Fighters '98 - The Slugfest / King of Fighters '98 - Dream Match Never Ends (NGM-2420, alt board)",
 		1998,
 		"SNK",
@@ -17090,6 +18293,7 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0x0a, 0xba, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17100,6 +18304,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7f, 0x7b, 0x48, 0x05},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17110,6 +18315,7 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0x12, 0xda, 0x0c},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17135,10 +18341,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x85, 0xea, 0x30},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -17184,10 +18392,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x23, 0xe0, 0x45},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof98,
+		"kof98",
 		"The King of Fighters '98 - The Slugfest / King of Fighters '98 - Dream Match Never Ends (Korean board, set 1)",
 		1998,
 		"SNK",
@@ -17212,6 +18422,7 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0x0a, 0xba, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17222,6 +18433,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7f, 0x7b, 0x48, 0x05},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17232,6 +18444,7 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0x9f, 0xb0, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17257,10 +18470,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x85, 0xea, 0x30},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -17306,10 +18521,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x23, 0xe0, 0x45},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof98,
+		"kof98",
 		"The King of Fighters '98 - The Slugfest / King of Fighters '98 - Dream Match Never Ends (Korean board, set 2)",
 		1998,
 		"SNK",
@@ -17334,6 +18551,7 @@ var mameGames = map[string]struct {
 							[]byte{0x98, 0x0a, 0xba, 0x4c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17344,6 +18562,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7f, 0x7b, 0x48, 0x05},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17354,6 +18573,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x7a, 0x6b, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17379,10 +18599,12 @@ var mameGames = map[string]struct {
 							[]byte{0x79, 0x85, 0xea, 0x30},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -17428,10 +18650,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x23, 0xe0, 0x45},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '98 - The Slugfest / King of Fighters '98 - Dream Match Never Ends (NGH-2420)",
 		1998,
 		"SNK",
@@ -17456,6 +18680,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xd4, 0xa3, 0x0b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17466,6 +18691,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc9, 0xcd, 0x22, 0x98},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17476,6 +18702,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0xf1, 0x2d, 0x10},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17501,10 +18728,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf5, 0xc6, 0x4b, 0xa6},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -17540,10 +18769,12 @@ var mameGames = map[string]struct {
 							[]byte{0xec, 0x9c, 0x36, 0xd0},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The Last Blade 2 / Bakumatsu Roman - Dai Ni Maku Gekka no Kenshi (NGM-2430 ~ NGH-2430)",
 		1998,
 		"SNK",
@@ -17563,6 +18794,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf8, 0xfd, 0xb7, 0xa5},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17573,6 +18805,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0xdd, 0xb6, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17583,6 +18816,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0x01, 0xb2, 0x76},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -17598,10 +18832,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb2, 0x31, 0x90, 0x2f},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -17617,10 +18853,12 @@ var mameGames = map[string]struct {
 							[]byte{0x33, 0xaa, 0x0f, 0x35},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Neo-Geo Cup '98 - The Road to the Victory",
 		1998,
 		"SNK",
@@ -17640,6 +18878,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0x28, 0x87, 0x6d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17650,6 +18889,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0x66, 0x0a, 0x5d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17660,6 +18900,7 @@ var mameGames = map[string]struct {
 							[]byte{0x00, 0xf3, 0x1c, 0x66},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -17675,10 +18916,12 @@ var mameGames = map[string]struct {
 							[]byte{0x90, 0x68, 0x19, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					25165824,
@@ -17714,10 +18957,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0x33, 0x73, 0x28},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Breakers Revenge",
 		1998,
 		"Visco",
@@ -17742,6 +18987,7 @@ var mameGames = map[string]struct {
 							[]byte{0x72, 0xea, 0x04, 0xc3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17752,6 +18998,7 @@ var mameGames = map[string]struct {
 							[]byte{0x2a, 0x36, 0x06, 0x37},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17762,6 +19009,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0x60, 0x4a, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -17782,10 +19030,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa0, 0x5b, 0xa5, 0xdb},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -17821,10 +19071,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7e, 0x2c, 0xaa, 0xe1},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Shock Troopers - 2nd Squad",
 		1998,
 		"Saurus",
@@ -17844,6 +19096,7 @@ var mameGames = map[string]struct {
 							[]byte{0x95, 0x77, 0x90, 0x94},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17854,6 +19107,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0x00, 0x18, 0x5c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17864,6 +19118,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa9, 0xfe, 0x01, 0x44},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -17874,10 +19129,12 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0xec, 0x74, 0x3d},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -17893,10 +19150,12 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x6d, 0x6e, 0x87},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Battle Flip Shot",
 		1999,
 		"Visco",
@@ -17916,6 +19175,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9d, 0x6c, 0x07, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -17926,6 +19186,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x3f, 0xee, 0x41},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -17936,6 +19197,7 @@ var mameGames = map[string]struct {
 							[]byte{0x88, 0x30, 0x97, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					8388608,
@@ -17951,10 +19213,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x96, 0x6e, 0xf3},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					10485760,
@@ -17980,10 +19244,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0xfe, 0xad, 0x3f},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Puzzle Bobble 2 / Bust-A-Move Again (Neo-Geo)",
 		1999,
 		"Taito (SNK license)",
@@ -18003,6 +19269,7 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x87, 0x19, 0x7d},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -18013,6 +19280,7 @@ var mameGames = map[string]struct {
 							[]byte{0x44, 0xe5, 0xf1, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -18023,6 +19291,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0xa7, 0x39, 0xad},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -18033,10 +19302,12 @@ var mameGames = map[string]struct {
 							[]byte{0x50, 0xfe, 0xff, 0xb0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -18052,10 +19323,12 @@ var mameGames = map[string]struct {
 							[]byte{0xce, 0x7b, 0x62, 0x48},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Bang Bang Busters",
 		2001,
 		"Visco",
@@ -18075,6 +19348,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc9, 0x38, 0x61, 0x18},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -18085,6 +19359,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdc, 0x9e, 0xb3, 0x72},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -18095,6 +19370,7 @@ var mameGames = map[string]struct {
 							[]byte{0x80, 0x32, 0x8a, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -18110,10 +19386,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0xe4, 0x01, 0x19},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -18129,10 +19407,12 @@ var mameGames = map[string]struct {
 							[]byte{0x74, 0xf3, 0xcd, 0xf4},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Captain Tomaday",
 		1999,
 		"Visco",
@@ -18157,6 +19437,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0xda, 0x2e, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -18167,6 +19448,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfb, 0x6f, 0x44, 0x1d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -18177,6 +19459,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfd, 0x42, 0xa8, 0x42},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -18197,10 +19480,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2c, 0xa6, 0x51, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -18236,10 +19521,12 @@ var mameGames = map[string]struct {
 							[]byte{0x83, 0xe3, 0xe6, 0x9d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof95a,
+		"kof95a",
 		"Metal Slug X - Super Vehicle-001 (NGM-2500 ~ NGH-2500)",
 		1999,
 		"SNK",
@@ -18269,10 +19556,12 @@ var mameGames = map[string]struct {
 							[]byte{0x90, 0x17, 0x5f, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -18283,6 +19572,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18308,10 +19598,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18357,10 +19649,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x92, 0x1c, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof99,
+		"kof99",
 		"The King of Fighters '99 - Millennium Battle (NGM-2510)",
 		1999,
 		"SNK",
@@ -18390,10 +19684,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd9, 0x05, 0x7f, 0x51},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -18404,6 +19700,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18429,10 +19726,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18478,10 +19777,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x92, 0x1c, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof99,
+		"kof99",
 		"The King of Fighters '99 - Millennium Battle (NGH-2510)",
 		1999,
 		"SNK",
@@ -18521,10 +19822,12 @@ var mameGames = map[string]struct {
 							[]byte{0x52, 0xde, 0x02, 0xae},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -18535,6 +19838,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18560,10 +19864,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18609,10 +19915,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x92, 0x1c, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof99,
+		"kof99",
 		"The King of Fighters '99 - Millennium Battle (earlier)",
 		1999,
 		"SNK",
@@ -18642,10 +19950,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0x89, 0x8e, 0xc2},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -18656,6 +19966,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18681,10 +19992,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18730,10 +20043,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x92, 0x1c, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof99,
+		"kof99",
 		"The King of Fighters '99 - Millennium Battle (Korean release)",
 		1999,
 		"SNK",
@@ -18758,10 +20073,12 @@ var mameGames = map[string]struct {
 							[]byte{0x27, 0x4e, 0xf4, 0x7a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -18772,6 +20089,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18797,10 +20115,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18846,10 +20166,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0x92, 0x1c, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof99ka,
+		"kof99ka",
 		"The King of Fighters '99 - Millennium Battle (Korean release, non-encrypted program)",
 		1999,
 		"SNK",
@@ -18874,6 +20196,7 @@ var mameGames = map[string]struct {
 							[]byte{0x73, 0x97, 0x42, 0xad},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -18884,6 +20207,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfb, 0x14, 0x98, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -18894,6 +20218,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5e, 0x74, 0x53, 0x9c},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -18919,10 +20244,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb4, 0x9e, 0x61, 0x78},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -18968,10 +20295,12 @@ var mameGames = map[string]struct {
 							[]byte{0xea, 0xd5, 0x13, 0xce},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '99 - Millennium Battle (prototype)",
 		1999,
 		"SNK",
@@ -18991,10 +20320,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x8a, 0xc4, 0xfb},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -19005,6 +20336,7 @@ var mameGames = map[string]struct {
 							[]byte{0x30, 0xcc, 0x40, 0x99},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -19015,10 +20347,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe5, 0x94, 0x67, 0x33},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -19034,10 +20368,12 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0x58, 0x54, 0x74},
 						},
 					},
+					0,
 				},
 			},
 		},
 		ganryu,
+		"ganryu",
 		"Ganryu / Musashi Ganryuki",
 		1999,
 		"Visco",
@@ -19077,10 +20413,12 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x92, 0xc0, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					262144,
@@ -19091,6 +20429,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0xa8, 0x06, 0xbe},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19116,10 +20455,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa5, 0x4b, 0xe8, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19165,10 +20506,12 @@ var mameGames = map[string]struct {
 							[]byte{0x21, 0xa1, 0x13, 0x03},
 						},
 					},
+					0,
 				},
 			},
 		},
 		garou,
+		"garou",
 		"Garou - Mark of the Wolves (NGM-2530)",
 		1999,
 		"SNK",
@@ -19198,10 +20541,12 @@ var mameGames = map[string]struct {
 							[]byte{0xaf, 0xff, 0xa7, 0x79},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					262144,
@@ -19212,6 +20557,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0xa8, 0x06, 0xbe},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19237,10 +20583,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa5, 0x4b, 0xe8, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19286,10 +20634,12 @@ var mameGames = map[string]struct {
 							[]byte{0x21, 0xa1, 0x13, 0x03},
 						},
 					},
+					0,
 				},
 			},
 		},
 		garouh,
+		"garouh",
 		"Garou - Mark of the Wolves (NGM-2530 ~ NGH-2530)",
 		1999,
 		"SNK",
@@ -19329,10 +20679,12 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x92, 0xc0, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					262144,
@@ -19343,6 +20695,7 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0xa8, 0x06, 0xbe},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19368,10 +20721,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa5, 0x4b, 0xe8, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19417,10 +20772,12 @@ var mameGames = map[string]struct {
 							[]byte{0x21, 0xa1, 0x13, 0x03},
 						},
 					},
+					0,
 				},
 			},
 		},
 		garou,
+		"garou",
 		"Garou - Mark of the Wolves (NGH-2530)",
 		1999,
 		"SNK",
@@ -19445,6 +20802,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbf, 0x8d, 0xe5, 0x65},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -19455,6 +20813,7 @@ var mameGames = map[string]struct {
 							[]byte{0x77, 0x99, 0x89, 0xde},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -19465,6 +20824,7 @@ var mameGames = map[string]struct {
 							[]byte{0xbb, 0xe4, 0x64, 0xf7},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19490,10 +20850,12 @@ var mameGames = map[string]struct {
 							[]byte{0x14, 0x98, 0x40, 0x63},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19539,10 +20901,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf7, 0x78, 0xfe, 0x99},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Garou - Mark of the Wolves (prototype)",
 		1999,
 		"SNK",
@@ -19567,10 +20931,12 @@ var mameGames = map[string]struct {
 							[]byte{0xef, 0xdf, 0xd4, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -19581,6 +20947,7 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x4b, 0x44, 0x87},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19606,10 +20973,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0x13, 0xdc, 0x91},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19655,10 +21024,12 @@ var mameGames = map[string]struct {
 							[]byte{0x66, 0x84, 0x8c, 0x7d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		s1945p,
+		"s1945p",
 		"Strikers 1945 Plus",
 		1999,
 		"Psikyo",
@@ -19683,10 +21054,12 @@ var mameGames = map[string]struct {
 							[]byte{0x42, 0x05, 0x0b, 0x80},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -19697,6 +21070,7 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0xfd, 0x40, 0x14},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -19712,10 +21086,12 @@ var mameGames = map[string]struct {
 							[]byte{0x66, 0x10, 0xd9, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -19751,10 +21127,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb0, 0x01, 0xbd, 0xd3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		preisle2,
+		"preisle2",
 		"Prehistoric Isle 2",
 		1999,
 		"Yumekobo / Saurus",
@@ -19784,10 +21162,12 @@ var mameGames = map[string]struct {
 							[]byte{0x60, 0x97, 0xc2, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -19798,6 +21178,7 @@ var mameGames = map[string]struct {
 							[]byte{0xea, 0xee, 0xc1, 0x16},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19823,10 +21204,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4b, 0x22, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19872,10 +21255,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x9b, 0xe3, 0x4c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug3,
+		"mslug3",
 		"Metal Slug 3 (NGM-2560)",
 		2000,
 		"SNK",
@@ -19905,10 +21290,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x65, 0x98, 0x26},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -19919,6 +21306,7 @@ var mameGames = map[string]struct {
 							[]byte{0xea, 0xee, 0xc1, 0x16},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -19944,10 +21332,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4b, 0x22, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -19993,10 +21383,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x9b, 0xe3, 0x4c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug3a,
+		"mslug3a",
 		"Metal Slug 3 (NGM-2560, earlier)",
 		2000,
 		"SNK",
@@ -20021,10 +21413,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1f, 0x3d, 0x8c, 0xe8},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20035,6 +21429,7 @@ var mameGames = map[string]struct {
 							[]byte{0xea, 0xee, 0xc1, 0x16},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20060,10 +21455,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4b, 0x22, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -20109,10 +21506,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x9b, 0xe3, 0x4c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug3h,
+		"mslug3h",
 		"Metal Slug 3 (NGH-2560)",
 		2000,
 		"SNK",
@@ -20142,10 +21541,12 @@ var mameGames = map[string]struct {
 							[]byte{0x1b, 0x7e, 0xc4, 0x15},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20156,6 +21557,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x74, 0x91, 0x13},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20181,10 +21583,12 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x4b, 0xbd, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -20230,10 +21634,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0xaf, 0xa6, 0x0b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2000,
+		"kof2000",
 		"The King of Fighters 2000 (NGM-2570 ~ NGH-2570)",
 		2000,
 		"SNK",
@@ -20258,10 +21664,12 @@ var mameGames = map[string]struct {
 							[]byte{0x69, 0x3c, 0x2c, 0x5e},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20272,6 +21680,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x74, 0x91, 0x13},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20297,10 +21706,12 @@ var mameGames = map[string]struct {
 							[]byte{0x76, 0x4b, 0xbd, 0x6b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -20346,10 +21757,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0xaf, 0xa6, 0x0b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2000n,
+		"kof2000n",
 		"The King of Fighters 2000 (not encrypted)",
 		2000,
 		"SNK",
@@ -20369,10 +21782,12 @@ var mameGames = map[string]struct {
 							[]byte{0x88, 0xa3, 0x7f, 0x8b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -20383,6 +21798,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0x66, 0x8e, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -20398,10 +21814,12 @@ var mameGames = map[string]struct {
 							[]byte{0x97, 0x52, 0x8f, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -20417,10 +21835,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0xfd, 0x98, 0xff},
 						},
 					},
+					0,
 				},
 			},
 		},
 		bangbead,
+		"bangbead",
 		"Bang Bead",
 		2000,
 		"Visco",
@@ -20440,10 +21860,12 @@ var mameGames = map[string]struct {
 							[]byte{0x61, 0x36, 0x10, 0x82},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20454,6 +21876,7 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0x07, 0xc5, 0xe5},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -20464,10 +21887,12 @@ var mameGames = map[string]struct {
 							[]byte{0x24, 0xb0, 0x48, 0x0c},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -20483,10 +21908,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd2, 0xb0, 0x4b, 0x0d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		nitd,
+		"nitd",
 		"Nightmare in the Dark",
 		2000,
 		"Eleven / Gavaking",
@@ -20506,10 +21933,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe0, 0xd4, 0xbc, 0x0a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20520,6 +21949,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x50, 0x1c, 0x39},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -20545,10 +21975,12 @@ var mameGames = map[string]struct {
 							[]byte{0x90, 0x00, 0xd0, 0x85},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -20574,10 +22006,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x45, 0xae, 0x53},
 						},
 					},
+					0,
 				},
 			},
 		},
 		sengoku3,
+		"sengoku3",
 		"Sengoku 3 / Sengoku Densho 2001 (set 1)",
 		2001,
 		"Noise Factory / SNK",
@@ -20597,10 +22031,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0x55, 0x72, 0x01},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20611,6 +22047,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7d, 0x50, 0x1c, 0x39},
 						},
 					},
+					0,
 				},
 				{
 					14680064,
@@ -20636,10 +22073,12 @@ var mameGames = map[string]struct {
 							[]byte{0x90, 0x00, 0xd0, 0x85},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -20665,10 +22104,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0b, 0x45, 0xae, 0x53},
 						},
 					},
+					0,
 				},
 			},
 		},
 		sengoku3,
+		"sengoku3",
 		"Sengoku 3 / Sengoku Densho 2001 (set 2)",
 		2001,
 		"Noise Factory / SNK",
@@ -20693,10 +22134,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8e, 0x0d, 0x83, 0x29},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20707,6 +22150,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0xf8, 0x11, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20732,10 +22176,12 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0xec, 0x4d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -20781,10 +22227,12 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x28, 0x9a, 0x6b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2001,
+		"kof2001",
 		"The King of Fighters 2001 (NGM-262?)",
 		2001,
 		"Eolith / SNK",
@@ -20809,10 +22257,12 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0xee, 0xa0, 0x62},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20823,6 +22273,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0xf8, 0x11, 0x9f},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20848,10 +22299,12 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0xec, 0x4d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -20897,10 +22350,12 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x28, 0x9a, 0x6b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2001,
+		"kof2001",
 		"The King of Fighters 2001 (NGH-2621)",
 		2001,
 		"Eolith / SNK",
@@ -20925,10 +22380,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfd, 0xb7, 0xae, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -20939,6 +22396,7 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0xac, 0x82, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -20954,10 +22412,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0xb2, 0xbf, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -20993,10 +22453,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5c, 0x8b, 0xa1, 0x16},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug4,
+		"mslug4",
 		"Metal Slug 4 (NGM-2630)",
 		2002,
 		"Mega / Playmore",
@@ -21021,10 +22483,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbc, 0x3e, 0xc8, 0x9e},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21035,6 +22499,7 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0xac, 0x82, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21050,10 +22515,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0xb2, 0xbf, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -21089,10 +22556,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5c, 0x8b, 0xa1, 0x16},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug4,
+		"mslug4",
 		"Metal Slug 4 (NGH-2630)",
 		2002,
 		"Mega / Playmore",
@@ -21112,10 +22581,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb8, 0xcc, 0x96, 0x9d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21126,6 +22597,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0xbd, 0x7b, 0x43},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21141,10 +22613,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc3, 0xdc, 0x8b, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21190,10 +22664,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc5, 0xed, 0xb5, 0xc4},
 						},
 					},
+					0,
 				},
 			},
 		},
 		rotd,
+		"rotd",
 		"Rage of the Dragons (NGM-2640?)",
 		2002,
 		"Evoga / Playmore",
@@ -21218,10 +22694,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0d, 0xf2, 0xe1, 0x12},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21232,6 +22710,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0xbd, 0x7b, 0x43},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21247,10 +22726,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc3, 0xdc, 0x8b, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21296,10 +22777,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc5, 0xed, 0xb5, 0xc4},
 						},
 					},
+					0,
 				},
 			},
 		},
 		rotd,
+		"rotd",
 		"Rage of the Dragons (NGH-2640?)",
 		2002,
 		"Evoga / Playmore",
@@ -21324,10 +22807,12 @@ var mameGames = map[string]struct {
 							[]byte{0x32, 0x72, 0x66, 0xb8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21338,6 +22823,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0xaa, 0xa6, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21353,10 +22839,12 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x41, 0xd6, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21402,10 +22890,12 @@ var mameGames = map[string]struct {
 							[]byte{0xab, 0x0b, 0xb5, 0x49},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2002,
+		"kof2002",
 		"The King of Fighters 2002 (NGM-2650 ~ NGH-2650)",
 		2002,
 		"Eolith / Playmore",
@@ -21430,10 +22920,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa1, 0x4b, 0x19, 0x06},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21444,6 +22936,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x6c, 0x3e, 0x6c},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21459,10 +22952,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0xf6, 0x9e, 0xda},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21508,10 +23003,12 @@ var mameGames = map[string]struct {
 							[]byte{0x29, 0x87, 0x3d, 0x33},
 						},
 					},
+					0,
 				},
 			},
 		},
 		matrim,
+		"matrim",
 		"Matrimelee / Shin Gouketsuji Ichizoku Toukon (NGM-2660 ~ NGH-2660)",
 		2002,
 		"Noise Factory / Atlus",
@@ -21531,10 +23028,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbf, 0x34, 0xe7, 0x1c},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21545,6 +23044,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0x85, 0x3c, 0xcd},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -21555,10 +23055,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xe8, 0xe9, 0x17},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -21574,10 +23076,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0x67, 0x18, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		pnyaa,
+		"pnyaa",
 		"Pochi and Nyaa (Ver 2.02)",
 		2003,
 		"Aiky / Taito",
@@ -21597,10 +23101,12 @@ var mameGames = map[string]struct {
 							[]byte{0x11, 0x2f, 0xe2, 0xc0},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21611,6 +23117,7 @@ var mameGames = map[string]struct {
 							[]byte{0xc7, 0x85, 0x3c, 0xcd},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -21621,10 +23128,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe2, 0xe8, 0xe9, 0x17},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -21640,10 +23149,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0x67, 0x18, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		pnyaa,
+		"pnyaa",
 		"Pochi and Nyaa (Ver 2.00)",
 		2003,
 		"Aiky / Taito",
@@ -21668,10 +23179,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfb, 0xf6, 0xb6, 0x1e},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21682,6 +23195,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x5a, 0x6e, 0x0e},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21697,10 +23211,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x06, 0x13, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21746,10 +23262,12 @@ var mameGames = map[string]struct {
 							[]byte{0x55, 0x1d, 0x72, 0x0e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug5,
+		"mslug5",
 		"Metal Slug 5 (NGM-2680)",
 		2003,
 		"SNK Playmore",
@@ -21774,10 +23292,12 @@ var mameGames = map[string]struct {
 							[]byte{0x8d, 0xfc, 0x47, 0xa2},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21788,6 +23308,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x5a, 0x6e, 0x0e},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21803,10 +23324,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x06, 0x13, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21852,10 +23375,12 @@ var mameGames = map[string]struct {
 							[]byte{0x55, 0x1d, 0x72, 0x0e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug5,
+		"mslug5",
 		"Metal Slug 5 (NGH-2680)",
 		2003,
 		"SNK Playmore",
@@ -21880,10 +23405,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0x13, 0x79, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -21894,6 +23421,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf6, 0x81, 0x9d, 0x00},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -21909,10 +23437,12 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0x90, 0x38, 0x35},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -21958,10 +23488,12 @@ var mameGames = map[string]struct {
 							[]byte{0x25, 0x70, 0xb7, 0x1b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		svc,
+		"svc",
 		"SNK vs. Capcom - SVC Chaos (NGM-2690 ~ NGH-2690)",
 		2003,
 		"Playmore / Capcom",
@@ -21986,10 +23518,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe0, 0xc7, 0x4c, 0x85},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22000,6 +23534,7 @@ var mameGames = map[string]struct {
 							[]byte{0x49, 0xc9, 0x90, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22015,10 +23550,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x0f, 0x3c, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22064,10 +23601,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0xc5, 0x30, 0xa6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsho5,
+		"samsho5",
 		"Samurai Shodown V / Samurai Spirits Zero (NGM-2700, set 1)",
 		2003,
 		"Yuki Enterprise / SNK Playmore",
@@ -22092,10 +23631,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2b, 0x84, 0x4f, 0xe9},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22106,6 +23647,7 @@ var mameGames = map[string]struct {
 							[]byte{0x49, 0xc9, 0x90, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22121,10 +23663,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x0f, 0x3c, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22170,10 +23714,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0xc5, 0x30, 0xa6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsho5,
+		"samsho5",
 		"Samurai Shodown V / Samurai Spirits Zero (NGM-2700, set 2)",
 		2003,
 		"Yuki Enterprise / SNK Playmore",
@@ -22198,10 +23744,12 @@ var mameGames = map[string]struct {
 							[]byte{0x94, 0x3a, 0x6b, 0x1d},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22212,6 +23760,7 @@ var mameGames = map[string]struct {
 							[]byte{0x49, 0xc9, 0x90, 0x1a},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22227,10 +23776,12 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0x0f, 0x3c, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22276,10 +23827,12 @@ var mameGames = map[string]struct {
 							[]byte{0x02, 0xc5, 0x30, 0xa6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsho5,
+		"samsho5",
 		"Samurai Shodown V / Samurai Spirits Zero (NGH-2700)",
 		2003,
 		"Yuki Enterprise / SNK Playmore",
@@ -22309,10 +23862,12 @@ var mameGames = map[string]struct {
 							[]byte{0xae, 0xc5, 0xb4, 0xa9},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22323,6 +23878,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf5, 0x51, 0x56, 0x29},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22338,10 +23894,12 @@ var mameGames = map[string]struct {
 							[]byte{0x53, 0x82, 0xc7, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22387,10 +23945,12 @@ var mameGames = map[string]struct {
 							[]byte{0x20, 0xec, 0x4f, 0xdc},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2003,
+		"kof2003",
 		"The King of Fighters 2003 (NGM-2710, Export)",
 		2003,
 		"SNK Playmore",
@@ -22420,10 +23980,12 @@ var mameGames = map[string]struct {
 							[]byte{0x23, 0x27, 0x02, 0xad},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22434,6 +23996,7 @@ var mameGames = map[string]struct {
 							[]byte{0x48, 0xd9, 0xaf, 0xfe},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22449,10 +24012,12 @@ var mameGames = map[string]struct {
 							[]byte{0x53, 0x82, 0xc7, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22498,10 +24063,12 @@ var mameGames = map[string]struct {
 							[]byte{0x31, 0x2f, 0x52, 0x8c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof2003h,
+		"kof2003h",
 		"The King of Fighters 2003 (NGH-2710)",
 		2003,
 		"SNK Playmore",
@@ -22526,10 +24093,12 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0x49, 0x2e, 0xa6},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22540,6 +24109,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xee, 0xbf, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22555,10 +24125,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0xa5, 0x07, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22604,10 +24176,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0x97, 0x73, 0xcd},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsh5sp,
+		"samsh5sp",
 		"Samurai Shodown V Special / Samurai Spirits Zero Special (NGM-2720)",
 		2004,
 		"Yuki Enterprise / SNK Playmore",
@@ -22632,10 +24206,12 @@ var mameGames = map[string]struct {
 							[]byte{0xbd, 0x64, 0xa5, 0x18},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22646,6 +24222,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xee, 0xbf, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22661,10 +24238,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0xa5, 0x07, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22710,10 +24289,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0x97, 0x73, 0xcd},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsh5sp,
+		"samsh5sp",
 		"Samurai Shodown V Special / Samurai Spirits Zero Special (NGH-2720, 2nd release, less censored)",
 		2004,
 		"Yuki Enterprise / SNK Playmore",
@@ -22738,10 +24319,12 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0x1a, 0x7d, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22752,6 +24335,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xee, 0xbf, 0x40},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -22767,10 +24351,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0xa5, 0x07, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -22816,10 +24402,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd4, 0x97, 0x73, 0xcd},
 						},
 					},
+					0,
 				},
 			},
 		},
 		samsh5sp,
+		"samsh5sp",
 		"Samurai Shodown V Special / Samurai Spirits Zero Special (NGH-2720, 1st release, censored)",
 		2004,
 		"Yuki Enterprise / SNK Playmore",
@@ -22839,10 +24427,12 @@ var mameGames = map[string]struct {
 							[]byte{0x2f, 0xb7, 0xf3, 0x88},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22853,6 +24443,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd1, 0x63, 0xc6, 0x90},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -22863,10 +24454,12 @@ var mameGames = map[string]struct {
 							[]byte{0x44, 0x3e, 0xad, 0xba},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -22882,10 +24475,12 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0x89, 0xee, 0xf9},
 						},
 					},
+					0,
 				},
 			},
 		},
 		jockeygp,
+		"jockeygp",
 		"Jockey Grand Prix (set 1)",
 		2001,
 		"Sun Amusement / BrezzaSoft",
@@ -22905,10 +24500,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb8, 0xf3, 0x55, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					131072,
 					[]mameROM{},
+					0,
 				},
 				{
 					524288,
@@ -22919,6 +24516,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd1, 0x63, 0xc6, 0x90},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -22929,10 +24527,12 @@ var mameGames = map[string]struct {
 							[]byte{0x44, 0x3e, 0xad, 0xba},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -22948,10 +24548,12 @@ var mameGames = map[string]struct {
 							[]byte{0x62, 0x89, 0xee, 0xf9},
 						},
 					},
+					0,
 				},
 			},
 		},
 		jockeygp,
+		"jockeygp",
 		"Jockey Grand Prix (set 2)",
 		2001,
 		"Sun Amusement / BrezzaSoft",
@@ -22976,6 +24578,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0x53, 0x44, 0x8c},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -22986,18 +24589,22 @@ var mameGames = map[string]struct {
 							[]byte{0x70, 0x64, 0x77, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					196608,
 					[]mameROM{},
+					0,
 				},
 				{
 					2097152,
 					[]mameROM{},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					33554432,
@@ -23013,10 +24620,12 @@ var mameGames = map[string]struct {
 							[]byte{0x36, 0x01, 0xd5, 0x68},
 						},
 					},
+					0,
 				},
 			},
 		},
 		dragonsh,
+		"dragonsh",
 		"Dragon's Heaven (development board)",
 		1997,
 		"Face",
@@ -23036,6 +24645,7 @@ var mameGames = map[string]struct {
 							[]byte{0x06, 0xc8, 0xfc, 0xa7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23046,6 +24656,7 @@ var mameGames = map[string]struct {
 							[]byte{0xa7, 0xab, 0x0e, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23056,6 +24667,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfd, 0x96, 0x27, 0xca},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -23066,10 +24678,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc0, 0x9f, 0x74, 0xf1},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -23085,10 +24699,12 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0x4e, 0xd4, 0xb3},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Zintrick / Oshidashi Zentrix (bootleg of CD version)",
 		1996,
 		"bootleg",
@@ -23108,6 +24724,7 @@ var mameGames = map[string]struct {
 							[]byte{0x09, 0x67, 0x55, 0x41},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23118,6 +24735,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0e, 0x6a, 0x7c, 0x73},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23128,6 +24746,7 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x48, 0x78, 0xcf},
 						},
 					},
+					0,
 				},
 				{
 					1048576,
@@ -23138,10 +24757,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x8c, 0xcd, 0xdc},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -23157,10 +24778,12 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0x6b, 0x12, 0x63},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Idol Mahjong Final Romance 2 (Neo-Geo, bootleg of CD version)",
 		1995,
 		"bootleg",
@@ -23180,6 +24803,7 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0x83, 0x61, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23190,6 +24814,7 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0xe0, 0x2d, 0xdd},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23200,6 +24825,7 @@ var mameGames = map[string]struct {
 							[]byte{0x63, 0xe2, 0x83, 0x43},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -23210,10 +24836,12 @@ var mameGames = map[string]struct {
 							[]byte{0x22, 0xd4, 0xb9, 0x3b},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -23229,10 +24857,12 @@ var mameGames = map[string]struct {
 							[]byte{0xd6, 0xc6, 0x18, 0x3d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Crossed Swords 2 (bootleg of CD version)",
 		1995,
 		"bootleg",
@@ -23262,6 +24892,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0x7c, 0x02, 0x21},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23272,6 +24903,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0xe2, 0x14, 0x9a},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23282,6 +24914,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x34, 0x87, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -23302,10 +24935,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x9e, 0xb9, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -23341,10 +24976,12 @@ var mameGames = map[string]struct {
 							[]byte{0x03, 0x1b, 0x1a, 0xd5},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kof97oro,
+		"kof97oro",
 		"The King of Fighters '97 Chongchu Jianghu Plus 2003 (bootleg)",
 		1997,
 		"bootleg",
@@ -23369,6 +25006,7 @@ var mameGames = map[string]struct {
 							[]byte{0x55, 0x02, 0xb0, 0x20},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23379,6 +25017,7 @@ var mameGames = map[string]struct {
 							[]byte{0x73, 0x25, 0x42, 0x70},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23389,6 +25028,7 @@ var mameGames = map[string]struct {
 							[]byte{0x45, 0x34, 0x87, 0x47},
 						},
 					},
+					0,
 				},
 				{
 					12582912,
@@ -23409,10 +25049,12 @@ var mameGames = map[string]struct {
 							[]byte{0x75, 0x9e, 0xb9, 0x54},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					41943040,
@@ -23448,10 +25090,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4f, 0xf4, 0xd4, 0x7b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"The King of Fighters '97 Plus (bootleg)",
 		1997,
 		"bootleg",
@@ -23481,6 +25125,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfa, 0xc5, 0xe2, 0xe7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23491,6 +25136,7 @@ var mameGames = map[string]struct {
 							[]byte{0x39, 0xe8, 0x28, 0x97},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23501,6 +25147,7 @@ var mameGames = map[string]struct {
 							[]byte{0xd0, 0x60, 0x4a, 0xd1},
 						},
 					},
+					0,
 				},
 				{
 					10485760,
@@ -23521,10 +25168,12 @@ var mameGames = map[string]struct {
 							[]byte{0x43, 0x7d, 0x1d, 0x8e},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -23560,10 +25209,12 @@ var mameGames = map[string]struct {
 							[]byte{0x67, 0x0a, 0xc1, 0x3b},
 						},
 					},
+					0,
 				},
 			},
 		},
 		lans2004,
+		"lans2004",
 		"Lansquenet 2004 (Shock Troopers - 2nd Squad bootleg)",
 		1998,
 		"bootleg",
@@ -23588,6 +25239,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3f, 0xb1, 0x0a, 0x84},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23598,6 +25250,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdf, 0x72, 0x0e, 0x33},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -23608,6 +25261,7 @@ var mameGames = map[string]struct {
 							[]byte{0x7c, 0x51, 0xd0, 0x02},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -23633,10 +25287,12 @@ var mameGames = map[string]struct {
 							[]byte{0x89, 0x18, 0xfd, 0xd3},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -23662,10 +25318,12 @@ var mameGames = map[string]struct {
 							[]byte{0xe0, 0x2a, 0x24, 0x2d},
 						},
 					},
+					0,
 				},
 			},
 		},
 		garoubl,
+		"garoubl",
 		"Garou - Mark of the Wolves (bootleg)",
 		1999,
 		"bootleg",
@@ -23690,6 +25348,7 @@ var mameGames = map[string]struct {
 							[]byte{0x19, 0x3f, 0xa8, 0x35},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23700,6 +25359,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6f, 0x8b, 0x96, 0x35},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -23710,6 +25370,7 @@ var mameGames = map[string]struct {
 							[]byte{0xea, 0xee, 0xc1, 0x16},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -23735,10 +25396,12 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x4b, 0x22, 0xd4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -23784,10 +25447,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4d, 0x9b, 0xe3, 0x4c},
 						},
 					},
+					0,
 				},
 			},
 		},
 		mslug3b6,
+		"mslug3b6",
 		"Metal Slug 6 (Metal Slug 3 bootleg)",
 		2000,
 		"bootleg",
@@ -23807,6 +25472,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x05, 0xbd, 0x1b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23817,6 +25483,7 @@ var mameGames = map[string]struct {
 							[]byte{0xdd, 0x3b, 0xf4, 0x7c},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -23827,6 +25494,7 @@ var mameGames = map[string]struct {
 							[]byte{0x64, 0x07, 0xc5, 0xe5},
 						},
 					},
+					0,
 				},
 				{
 					4194304,
@@ -23842,10 +25510,12 @@ var mameGames = map[string]struct {
 							[]byte{0x72, 0x85, 0x58, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					8388608,
@@ -23871,10 +25541,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4c, 0x39, 0x26, 0xe6},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Nightmare in the Dark (bootleg)",
 		2001,
 		"bootleg",
@@ -23899,6 +25571,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xc1, 0xc2, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -23909,6 +25582,7 @@ var mameGames = map[string]struct {
 							[]byte{0x5b, 0xa2, 0x9a, 0xab},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -23919,6 +25593,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x8c, 0x27, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -23944,10 +25619,12 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0xec, 0x4d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -23993,10 +25670,12 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x7a, 0x14, 0x39},
 						},
 					},
+					0,
 				},
 			},
 		},
 		cthd2003,
+		"cthd2003",
 		"Crouching Tiger Hidden Dragon 2003 (The King of Fighters 2001 bootleg)",
 		2003,
 		"bootleg",
@@ -24021,6 +25700,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xc1, 0xc2, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -24031,6 +25711,7 @@ var mameGames = map[string]struct {
 							[]byte{0x6c, 0x35, 0x5a, 0xb4},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -24041,6 +25722,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x8c, 0x27, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24066,10 +25748,12 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0xec, 0x4d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24115,10 +25799,12 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x7a, 0x14, 0x39},
 						},
 					},
+					0,
 				},
 			},
 		},
 		ct2k3sp,
+		"ct2k3sp",
 		"Crouching Tiger Hidden Dragon 2003 Super Plus (The King of Fighters 2001 bootleg)",
 		2003,
 		"bootleg",
@@ -24143,6 +25829,7 @@ var mameGames = map[string]struct {
 							[]byte{0xad, 0xc1, 0xc2, 0x2b},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -24153,6 +25840,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4e, 0x1f, 0x7e, 0xae},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -24163,6 +25851,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x8c, 0x27, 0x4b},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24188,10 +25877,12 @@ var mameGames = map[string]struct {
 							[]byte{0x26, 0xec, 0x4d, 0xd9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24237,10 +25928,12 @@ var mameGames = map[string]struct {
 							[]byte{0x91, 0x7a, 0x14, 0x39},
 						},
 					},
+					0,
 				},
 			},
 		},
 		ct2k3sa,
+		"ct2k3sa",
 		"Crouching Tiger Hidden Dragon 2003 Super Plus alternate (The King of Fighters 2001 bootleg)",
 		2003,
 		"bootleg",
@@ -24265,6 +25958,7 @@ var mameGames = map[string]struct {
 							[]byte{0xfd, 0xb7, 0xae, 0xd8},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -24275,6 +25969,7 @@ var mameGames = map[string]struct {
 							[]byte{0x07, 0xff, 0x87, 0xce},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -24285,6 +25980,7 @@ var mameGames = map[string]struct {
 							[]byte{0x46, 0xac, 0x82, 0x28},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24300,10 +25996,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0xb2, 0xbf, 0x81},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					50331648,
@@ -24339,10 +26037,12 @@ var mameGames = map[string]struct {
 							[]byte{0x5c, 0x8b, 0xa1, 0x16},
 						},
 					},
+					0,
 				},
 			},
 		},
 		ms4plus,
+		"ms4plus",
 		"Metal Slug 4 Plus (bootleg)",
 		2002,
 		"bootleg",
@@ -24367,6 +26067,7 @@ var mameGames = map[string]struct {
 							[]byte{0x32, 0x72, 0x66, 0xb8},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -24377,6 +26078,7 @@ var mameGames = map[string]struct {
 							[]byte{0x59, 0x5e, 0x00, 0x06},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -24387,6 +26089,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0xaa, 0xa6, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24402,10 +26105,12 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x41, 0xd6, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24451,10 +26156,12 @@ var mameGames = map[string]struct {
 							[]byte{0xab, 0x0b, 0xb5, 0x49},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kf2k2pls,
+		"kf2k2pls",
 		"The King of Fighters 2002 Plus (bootleg set 1)",
 		2002,
 		"bootleg",
@@ -24479,6 +26186,7 @@ var mameGames = map[string]struct {
 							[]byte{0x32, 0x72, 0x66, 0xb8},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -24489,6 +26197,7 @@ var mameGames = map[string]struct {
 							[]byte{0x1a, 0x3e, 0xd0, 0x64},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -24499,6 +26208,7 @@ var mameGames = map[string]struct {
 							[]byte{0x85, 0xaa, 0xa6, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24514,10 +26224,12 @@ var mameGames = map[string]struct {
 							[]byte{0xda, 0x41, 0xd6, 0xf9},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24563,10 +26275,12 @@ var mameGames = map[string]struct {
 							[]byte{0xab, 0x0b, 0xb5, 0x49},
 						},
 					},
+					0,
 				},
 			},
 		},
 		kf2k2pls,
+		"kf2k2pls",
 		"The King of Fighters 2002 Plus (bootleg set 2)",
 		2002,
 		"bootleg",
@@ -24586,10 +26300,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb1, 0xfd, 0x0c, 0x43},
 						},
 					},
+					0,
 				},
 				{
 					262144,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -24600,6 +26316,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf6, 0xfa, 0xb8, 0x59},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24615,10 +26332,12 @@ var mameGames = map[string]struct {
 							[]byte{0xb8, 0xc4, 0x75, 0xa4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24704,10 +26423,12 @@ var mameGames = map[string]struct {
 							[]byte{0x66, 0x1b, 0x7a, 0x52},
 						},
 					},
+					0,
 				},
 			},
 		},
 		unsupported,
+		"unsupported",
 		"The King of Fighters 10th Anniversary (The King of Fighters 2002 bootleg)",
 		2002,
 		"bootleg",
@@ -24732,10 +26453,12 @@ var mameGames = map[string]struct {
 							[]byte{0xa1, 0x4b, 0x19, 0x06},
 						},
 					},
+					0,
 				},
 				{
 					524288,
 					[]mameROM{},
+					0,
 				},
 				{
 					131072,
@@ -24746,6 +26469,7 @@ var mameGames = map[string]struct {
 							[]byte{0x3e, 0xa9, 0x6a, 0xb1},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24771,10 +26495,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc8, 0xc7, 0x9b, 0x19},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24820,10 +26546,12 @@ var mameGames = map[string]struct {
 							[]byte{0x90, 0x6c, 0xf2, 0x67},
 						},
 					},
+					0,
 				},
 			},
 		},
 		matrimbl,
+		"matrimbl",
 		"Matrimelee / Shin Gouketsuji Ichizoku Toukon (bootleg)",
 		2002,
 		"bootleg",
@@ -24853,6 +26581,7 @@ var mameGames = map[string]struct {
 							[]byte{0x43, 0x9e, 0xc0, 0x31},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -24863,6 +26592,7 @@ var mameGames = map[string]struct {
 							[]byte{0x21, 0xe0, 0x44, 0x32},
 						},
 					},
+					0,
 				},
 				{
 					524288,
@@ -24873,6 +26603,7 @@ var mameGames = map[string]struct {
 							[]byte{0x4a, 0x5a, 0x6e, 0x0e},
 						},
 					},
+					0,
 				},
 				{
 					16777216,
@@ -24888,10 +26619,12 @@ var mameGames = map[string]struct {
 							[]byte{0xc4, 0x06, 0x13, 0xed},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					67108864,
@@ -24937,10 +26670,12 @@ var mameGames = map[string]struct {
 							[]byte{0x55, 0x1d, 0x72, 0x0e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		ms5plus,
+		"ms5plus",
 		"Metal Slug 5 Plus (bootleg)",
 		2003,
 		"bootleg",
@@ -24960,6 +26695,7 @@ var mameGames = map[string]struct {
 							[]byte{0xac, 0x1e, 0x9e, 0xf3},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -24970,6 +26706,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9c, 0xaa, 0xe5, 0x38},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -24980,6 +26717,7 @@ var mameGames = map[string]struct {
 							[]byte{0xf4, 0x24, 0x36, 0x8a},
 						},
 					},
+					0,
 				},
 				{
 					3670016,
@@ -24995,10 +26733,12 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x54, 0x89, 0x48},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					1048576,
@@ -25014,10 +26754,12 @@ var mameGames = map[string]struct {
 							[]byte{0x4b, 0x42, 0xd7, 0xeb},
 						},
 					},
+					0,
 				},
 			},
 		},
 		pbobblenb,
+		"pbobblenb",
 		"Puzzle Bobble / Bust-A-Move (Neo-Geo, bootleg)",
 		1994,
 		"bootleg",
@@ -25037,6 +26779,7 @@ var mameGames = map[string]struct {
 							[]byte{0xed, 0xa4, 0x33, 0xd7},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -25047,6 +26790,7 @@ var mameGames = map[string]struct {
 							[]byte{0x9b, 0x31, 0x68, 0xf0},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -25057,6 +26801,7 @@ var mameGames = map[string]struct {
 							[]byte{0xe7, 0x77, 0xa2, 0x34},
 						},
 					},
+					0,
 				},
 				{
 					2097152,
@@ -25067,10 +26812,12 @@ var mameGames = map[string]struct {
 							[]byte{0xee, 0x15, 0xbd, 0xa4},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					1048576,
@@ -25086,10 +26833,12 @@ var mameGames = map[string]struct {
 							[]byte{0x3e, 0x63, 0x21, 0x61},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Digger Man (prototype)",
 		2000,
 		"Kyle Hodgetts",
@@ -25109,6 +26858,7 @@ var mameGames = map[string]struct {
 							[]byte{0x37, 0x76, 0xa8, 0x8f},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -25119,6 +26869,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0c, 0x0f, 0xf9, 0xe6},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -25129,6 +26880,7 @@ var mameGames = map[string]struct {
 							[]byte{0x11, 0x3c, 0x87, 0x0f},
 						},
 					},
+					0,
 				},
 				{
 					6291456,
@@ -25149,10 +26901,12 @@ var mameGames = map[string]struct {
 							[]byte{0x6d, 0x51, 0x07, 0xe2},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					16777216,
@@ -25178,10 +26932,12 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0x86, 0xad, 0x9e},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Last Hope",
 		2005,
 		"NG:DEV.TEAM",
@@ -25201,6 +26957,7 @@ var mameGames = map[string]struct {
 							[]byte{0x99, 0x60, 0x45, 0x39},
 						},
 					},
+					0,
 				},
 				{
 					262144,
@@ -25211,6 +26968,7 @@ var mameGames = map[string]struct {
 							[]byte{0x0a, 0x3f, 0xee, 0x41},
 						},
 					},
+					0,
 				},
 				{
 					131072,
@@ -25221,6 +26979,7 @@ var mameGames = map[string]struct {
 							[]byte{0x18, 0xb2, 0x3a, 0xce},
 						},
 					},
+					0,
 				},
 				{
 					5242880,
@@ -25241,10 +27000,12 @@ var mameGames = map[string]struct {
 							[]byte{0x84, 0xb6, 0x2c, 0x5d},
 						},
 					},
+					0,
 				},
 				{
 					0,
 					[]mameROM{},
+					0,
 				},
 				{
 					4194304,
@@ -25260,10 +27021,12 @@ var mameGames = map[string]struct {
 							[]byte{0xf3, 0x62, 0xc2, 0x71},
 						},
 					},
+					0,
 				},
 			},
 		},
 		common,
+		"common",
 		"Treasures of The Caribbean",
 		2010,
 		"Face / NCI",
@@ -25271,3 +27034,225 @@ var mameGames = map[string]struct {
 		0,
 	},
 }
+
+// mameReaders is every reader used by a game in the table by name
+var mameReaders = map[string]gameReader{
+	"bangbead":    bangbead,
+	"common":      common,
+	"ct2k3sa":     ct2k3sa,
+	"ct2k3sp":     ct2k3sp,
+	"cthd2003":    cthd2003,
+	"dragonsh":    dragonsh,
+	"fightfeva":   fightfeva,
+	"ganryu":      ganryu,
+	"garou":       garou,
+	"garoubl":     garoubl,
+	"garouh":      garouh,
+	"gpilotsp":    gpilotsp,
+	"jockeygp":    jockeygp,
+	"kf2k2pls":    kf2k2pls,
+	"kof2000":     kof2000,
+	"kof2000n":    kof2000n,
+	"kof2001":     kof2001,
+	"kof2002":     kof2002,
+	"kof2003":     kof2003,
+	"kof2003h":    kof2003h,
+	"kof95a":      kof95a,
+	"kof97oro":    kof97oro,
+	"kof98":       kof98,
+	"kof99":       kof99,
+	"kof99ka":     kof99ka,
+	"kotm2":       kotm2,
+	"kotm2p":      kotm2p,
+	"lans2004":    lans2004,
+	"matrim":      matrim,
+	"matrimbl":    matrimbl,
+	"ms4plus":     ms4plus,
+	"ms5plus":     ms5plus,
+	"mslug3":      mslug3,
+	"mslug3a":     mslug3a,
+	"mslug3b6":    mslug3b6,
+	"mslug3h":     mslug3h,
+	"mslug4":      mslug4,
+	"mslug5":      mslug5,
+	"nitd":        nitd,
+	"pbobblenb":   pbobblenb,
+	"pnyaa":       pnyaa,
+	"preisle2":    preisle2,
+	"rotd":        rotd,
+	"s1945p":      s1945p,
+	"samsh5sp":    samsh5sp,
+	"samsho5":     samsho5,
+	"sengoku3":    sengoku3,
+	"svc":         svc,
+	"unsupported": unsupported,
+	"viewpoin":    viewpoin,
+	"zupapa":      zupapa,
+}
+
+// mameNGH is the game for each NGH number found in the filenames of the ROM
+// images, preferring parents
+var mameNGH = map[uint16]string{
+	0x001: "nam1975",
+	0x002: "bstars",
+	0x003: "tpgolf",
+	0x004: "mahretsu",
+	0x005: "maglord",
+	0x006: "ridhero",
+	0x007: "alpham2",
+	0x008: "jockeygp",
+	0x009: "ncombat",
+	0x010: "cyberlip",
+	0x011: "superspy",
+	0x014: "mutnat",
+	0x016: "kotm",
+	0x017: "sengoku",
+	0x018: "burningf",
+	0x019: "lbowling",
+	0x020: "gpilots",
+	0x021: "joyjoy",
+	0x022: "bjourney",
+	0x023: "quizdais",
+	0x024: "lresort",
+	0x025: "eightman",
+	0x027: "minasan",
+	0x029: "legendos",
+	0x030: "2020bb",
+	0x031: "socbrawl",
+	0x032: "roboarmy",
+	0x033: "fatfury1",
+	0x034: "fbfrenzy",
+	0x036: "bakatono",
+	0x037: "crsword",
+	0x038: "trally",
+	0x039: "kotm2",
+	0x040: "sengoku2",
+	0x041: "bstars2",
+	0x042: "quizdai2",
+	0x043: "3countb",
+	0x044: "aof",
+	0x045: "samsho",
+	0x046: "tophuntr",
+	0x047: "fatfury2",
+	0x048: "janshin",
+	0x049: "androdun",
+	0x050: "ncommand",
+	0x051: "viewpoin",
+	0x052: "ssideki",
+	0x053: "wh1",
+	0x054: "crswd2bl",
+	0x055: "kof94",
+	0x056: "aof2",
+	0x057: "wh2",
+	0x058: "fatfursp",
+	0x059: "savagere",
+	0x060: "fightfev",
+	0x061: "ssideki2",
+	0x062: "spinmast",
+	0x063: "samsho2",
+	0x064: "wh2j",
+	0x065: "wjammers",
+	0x066: "karnovr",
+	0x067: "gururin",
+	0x068: "pspikes2",
+	0x069: "fatfury3",
+	0x070: "zupapa",
+	0x071: "b2b",
+	0x073: "panicbom",
+	0x074: "aodk",
+	0x075: "sonicwi2",
+	0x076: "zedblade",
+	0x078: "galaxyfg",
+	0x079: "strhoop",
+	0x080: "quizkof",
+	0x081: "ssideki3",
+	0x082: "doubledr",
+	0x083: "pbobblen",
+	0x084: "kof95",
+	0x086: "twsoc96",
+	0x087: "samsho3",
+	0x088: "stakwin",
+	0x089: "pulstar",
+	0x090: "whp",
+	0x092: "kabukikl",
+	0x093: "neobombe",
+	0x094: "gowcaizr",
+	0x095: "rbff1",
+	0x096: "aof3",
+	0x097: "sonicwi3",
+	0x098: "froman2b",
+	0x123: "quizdaisk",
+	0x134: "lastsold",
+	0x140: "rbff2k",
+	0x151: "kof99k",
+	0x152: "kof99ka",
+	0x187: "fswords",
+	0x196: "aof3k",
+	0x200: "turfmast",
+	0x201: "mslug",
+	0x202: "puzzledp",
+	0x203: "moshougi",
+	0x206: "marukodq",
+	0x207: "neomrdo",
+	0x208: "sdodgeb",
+	0x209: "goalx3",
+	0x212: "overtop",
+	0x213: "neodrift",
+	0x214: "kof96",
+	0x215: "ssideki4",
+	0x216: "kizuna",
+	0x217: "ninjamas",
+	0x218: "ragnagrd",
+	0x219: "pgoal",
+	0x220: "ironclad",
+	0x221: "magdrop2",
+	0x222: "samsho4",
+	0x223: "rbffspec",
+	0x224: "twinspri",
+	0x225: "wakuwak7",
+	0x227: "stakwin2",
+	0x228: "ghostlop",
+	0x230: "breakers",
+	0x231: "miexchng",
+	0x232: "kof97",
+	0x233: "magdrop3",
+	0x234: "lastblad",
+	0x235: "puzzldpr",
+	0x237: "popbounc",
+	0x238: "shocktro",
+	0x239: "blazstar",
+	0x240: "rbff2",
+	0x241: "mslug2",
+	0x242: "kof98",
+	0x243: "lastbld2",
+	0x244: "neocup98",
+	0x245: "breakrev",
+	0x246: "shocktr2",
+	0x247: "flipshot",
+	0x248: "pbobbl2n",
+	0x249: "ctomaday",
+	0x250: "mslugx",
+	0x251: "kof99",
+	0x252: "ganryu",
+	0x253: "garou",
+	0x254: "s1945p",
+	0x255: "preisle2",
+	0x256: "mslug3",
+	0x257: "kof2000",
+	0x259: "bangbead",
+	0x260: "nitd",
+	0x261: "sengoku3",
+	0x262: "kof2001",
+	0x263: "mslug4",
+	0x264: "rotd",
+	0x265: "kof2002",
+	0x266: "matrim",
+	0x267: "pnyaa",
+	0x268: "mslug5",
+	0x269: "svc",
+	0x270: "samsho5",
+	0x271: "kof2003",
+	0x272: "samsh5sp",
+	0x299: "mslug3b6",
+	0x316: "totc",
+}
@@ -0,0 +1,131 @@
+package neo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Header is the metadata stored at the start of a .neo file
+type Header struct {
+	Size         [Areas]uint32
+	Year         uint32
+	Genre        Genre
+	Screenshot   uint32
+	NGH          uint32
+	Name         string
+	Manufacturer string
+}
+
+// length returns the combined size of the header and all of the ROM areas
+func (h Header) length() int64 {
+	n := int64(headerSize)
+	for _, size := range h.Size {
+		n += int64(size)
+	}
+	return n
+}
+
+// Validate checks the name and manufacturer fit in the fixed size fields
+// used by the .neo format
+func (h Header) Validate() error {
+	if len(h.Name) > nameLength {
+		return fmt.Errorf("neo: name is %d bytes, maximum is %d", len(h.Name), nameLength)
+	}
+
+	if len(h.Manufacturer) > manufacturerLength {
+		return fmt.Errorf("neo: manufacturer is %d bytes, maximum is %d", len(h.Manufacturer), manufacturerLength)
+	}
+
+	return nil
+}
+
+// ReadHeader decodes just the metadata at the start of the .neo file read
+// from r and checks the sizes of the ROM areas add up to the length of the
+// file. If r is also an io.Seeker the length is found by seeking to the end
+// and back, otherwise the remainder of r is read and discarded
+func ReadHeader(r io.Reader) (Header, error) {
+	var hf headerFields
+	if err := binary.Read(r, binary.LittleEndian, &hf); err != nil {
+		return Header{}, err
+	}
+
+	if !hf.isValid() {
+		return Header{}, errInvalid
+	}
+
+	h := hf.header()
+
+	read := int64(binary.Size(hf))
+
+	var remaining int64
+	if s, ok := r.(io.Seeker); ok {
+		current, err := s.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return Header{}, err
+		}
+
+		end, err := s.Seek(0, io.SeekEnd)
+		if err != nil {
+			return Header{}, err
+		}
+
+		if _, err := s.Seek(current, io.SeekStart); err != nil {
+			return Header{}, err
+		}
+
+		remaining = end - current
+	} else {
+		var err error
+		if remaining, err = io.Copy(ioutil.Discard, r); err != nil {
+			return Header{}, err
+		}
+	}
+
+	switch length := read + remaining; {
+	case length < h.length():
+		return Header{}, io.ErrUnexpectedEOF
+	case length > h.length():
+		return Header{}, errTooMuch
+	}
+
+	return h, nil
+}
+
+// ReadWriterAt is the interface that groups the basic ReadAt and WriteAt
+// methods
+type ReadWriterAt interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// UpdateHeader overwrites the metadata at the start of the .neo file
+// accessed through rw with h. The rest of the header and the ROM areas are
+// left untouched so the ROM area sizes in h must match those already in the
+// file
+func UpdateHeader(rw ReadWriterAt, h Header) error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+
+	ff, err := readFileFields(io.NewSectionReader(rw, 0, headerSize))
+	if err != nil {
+		return err
+	}
+
+	if ff.header().Size != h.Size {
+		return errSizeMismatch
+	}
+
+	hf := ff.headerFields
+	hf.setHeader(h)
+
+	b := new(bytes.Buffer)
+	// Writes to bytes.Buffer never error
+	_ = binary.Write(b, binary.LittleEndian, &hf)
+
+	_, err = rw.WriteAt(b.Bytes(), 0)
+	return err
+}
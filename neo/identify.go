@@ -0,0 +1,106 @@
+package neo
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	crcGamesOnce sync.Once
+	crcGames     map[string][]string
+)
+
+// gamesByCRC returns a reverse index of every ROM checksum to the games
+// that use it
+func gamesByCRC() map[string][]string {
+	crcGamesOnce.Do(func() {
+		crcGames = make(map[string][]string)
+		for name, g := range mameGames {
+			for _, a := range g.area {
+				for _, r := range a.rom {
+					if !r.nodump() {
+						crcGames[string(r.crc)] = append(crcGames[string(r.crc)], name)
+					}
+				}
+			}
+		}
+	})
+	return crcGames
+}
+
+func gameCRCs(name string) map[string]struct{} {
+	crcs := make(map[string]struct{})
+	if g, ok := mameGames[name]; ok {
+		for _, a := range g.area {
+			for _, r := range a.rom {
+				if !r.nodump() {
+					crcs[string(r.crc)] = struct{}{}
+				}
+			}
+		}
+	}
+	return crcs
+}
+
+type candidate struct {
+	name   string
+	found  int
+	parent bool
+}
+
+// identify returns the game whose ROMs best match the files in s. Every ROM
+// of the game must either be in s or be shared with its parent, which is
+// the case for a split clone set. If more than one game matches then the
+// one using the most files in s is chosen and then parents over clones
+func identify(s *sourceIndex) (string, bool) {
+	seen := make(map[string]struct{})
+	for _, file := range s.files {
+		for _, name := range gamesByCRC()[string(file.crc)] {
+			seen[name] = struct{}{}
+		}
+	}
+
+	var candidates []candidate
+
+	for name := range seen {
+		g := mameGames[name]
+		parent := gameCRCs(g.parent)
+
+		found, complete := 0, true
+		for _, a := range g.area {
+			for _, r := range a.rom {
+				if r.nodump() {
+					continue
+				}
+				if _, ok := s.findCRC(r.crc); ok {
+					found++
+					continue
+				}
+				if _, ok := parent[string(r.crc)]; !ok {
+					complete = false
+				}
+			}
+		}
+
+		if complete && found > 0 {
+			candidates = append(candidates, candidate{name, found, g.parent == ""})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		switch {
+		case candidates[i].found != candidates[j].found:
+			return candidates[i].found > candidates[j].found
+		case candidates[i].parent != candidates[j].parent:
+			return candidates[i].parent
+		default:
+			return candidates[i].name < candidates[j].name
+		}
+	})
+
+	return candidates[0].name, true
+}
@@ -0,0 +1,43 @@
+package neo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sourceFor(games ...string) *sourceIndex {
+	s := &sourceIndex{
+		crc: make(map[string]int),
+	}
+	for _, name := range games {
+		for _, a := range mameGames[name].area {
+			for _, r := range a.rom {
+				if _, ok := s.crc[string(r.crc)]; !ok {
+					s.crc[string(r.crc)] = len(s.files)
+					s.files = append(s.files, indexedFile{r.filename, r.size, r.crc})
+				}
+			}
+		}
+	}
+	return s
+}
+
+func TestIdentify(t *testing.T) {
+	tables := []struct {
+		source *sourceIndex
+		game   string
+		ok     bool
+	}{
+		{sourceFor("maglord"), "maglord", true},
+		{sourceFor("maglordh"), "maglordh", true},
+		{sourceFor("kof98", "kof98k"), "kof98", true},
+		{sourceFor(), "", false},
+	}
+
+	for _, table := range tables {
+		game, ok := identify(table.source)
+		assert.Equal(t, table.ok, ok)
+		assert.Equal(t, table.game, game)
+	}
+}
@@ -0,0 +1,339 @@
+package neo
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bodgit/rom"
+)
+
+// romSource is a zip archive, directory or file system of ROM images
+type romSource interface {
+	Files() []string
+	Open(string) (io.ReadCloser, error)
+	Size(string) (uint64, error)
+	CRC(string) ([]byte, error)
+	Close() error
+}
+
+// pathSource reads a zip archive or directory of ROM images on disk
+type pathSource struct {
+	rom.Reader
+}
+
+func openPath(path string) (romSource, error) {
+	r, err := rom.NewReader(path)
+	if err != nil {
+		return nil, err
+	}
+	return pathSource{r}, nil
+}
+
+func (s pathSource) CRC(file string) ([]byte, error) {
+	return s.Checksum(file, rom.CRC32)
+}
+
+type indexedFile struct {
+	name string
+	size uint64
+	crc  []byte
+}
+
+// sourceIndex records the size and CRC32 checksum of every file in a zip
+// archive, directory or file system of ROM images
+type sourceIndex struct {
+	path  string
+	open  func() (romSource, error)
+	files []indexedFile
+	crc   map[string]int
+}
+
+func newSourceIndex(path string, open func() (romSource, error)) (*sourceIndex, error) {
+	r, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	s := &sourceIndex{
+		path: path,
+		open: open,
+		crc:  make(map[string]int),
+	}
+
+	for _, file := range r.Files() {
+		size, err := r.Size(file)
+		if err != nil {
+			return nil, err
+		}
+
+		crc, err := r.CRC(file)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the first file if there are duplicates
+		if _, ok := s.crc[string(crc)]; !ok {
+			s.crc[string(crc)] = len(s.files)
+		}
+
+		s.files = append(s.files, indexedFile{
+			name: file,
+			size: size,
+			crc:  crc,
+		})
+	}
+
+	return s, nil
+}
+
+func (s *sourceIndex) findCRC(crc []byte) (indexedFile, bool) {
+	if i, ok := s.crc[string(crc)]; ok {
+		return s.files[i], true
+	}
+	return indexedFile{}, false
+}
+
+type indexEntry struct {
+	once sync.Once
+	*sourceIndex
+	err error
+}
+
+// Index caches the CRC32 checksums of the ROM images in each zip archive or
+// directory so they are only computed once. An Index can be shared between
+// conversions, including concurrent ones, so a parent ROM set is only
+// indexed once for all of its clones
+type Index struct {
+	mu      sync.Mutex
+	sources map[string]*indexEntry
+	rompath []string
+}
+
+// NewIndex returns a new empty Index. Like MAME, each directory in rompath
+// is searched for a zip archive or directory named after the game and each
+// of its ancestors, in addition to the directory containing the ROM set
+func NewIndex(rompath ...string) *Index {
+	return &Index{
+		sources: make(map[string]*indexEntry),
+		rompath: rompath,
+	}
+}
+
+func (idx *Index) source(path string) (*sourceIndex, error) {
+	path = filepath.Clean(path)
+
+	idx.mu.Lock()
+	e, ok := idx.sources[path]
+	if !ok {
+		e = new(indexEntry)
+		idx.sources[path] = e
+	}
+	idx.mu.Unlock()
+
+	e.once.Do(func() {
+		e.sourceIndex, e.err = newSourceIndex(path, func() (romSource, error) {
+			return openPath(path)
+		})
+	})
+
+	return e.sourceIndex, e.err
+}
+
+// romSet is a ROM set to be converted along with wherever else its ROM
+// images might be found
+type romSet interface {
+	// name returns the name of the ROM set without any extension
+	name() string
+	// source returns the ROM set itself
+	source() (*sourceIndex, error)
+	// sources returns the sources to search for the ROM images of the
+	// named game in order
+	sources(game string) ([]*sourceIndex, error)
+}
+
+// pathSet is a ROM set on disk
+type pathSet struct {
+	idx  *Index
+	path string
+}
+
+func (ps *pathSet) name() string {
+	return strings.TrimSuffix(filepath.Base(ps.path), filepath.Ext(ps.path))
+}
+
+func (ps *pathSet) source() (*sourceIndex, error) {
+	return ps.idx.source(ps.path)
+}
+
+func (ps *pathSet) sources(game string) ([]*sourceIndex, error) {
+	return ps.idx.gameSources(ps.path, game)
+}
+
+// gameSources returns the sources that may hold the ROM images for the game
+// at path in the order they should be searched, first those for the game
+// itself and then those for each ancestor in turn
+func (idx *Index) gameSources(path, name string) ([]*sourceIndex, error) {
+	var sources []*sourceIndex
+	var missing error
+
+	games, seen := make(map[string]bool), make(map[string]bool)
+	for game := name; game != "" && !games[game]; game = mameGames[game].parent {
+		games[game] = true
+
+		paths := []string{filepath.Join(filepath.Dir(path), game+filepath.Ext(path))}
+		if game == name {
+			paths[0] = path
+		}
+		for _, dir := range idx.rompath {
+			paths = append(paths, filepath.Join(dir, game+".zip"), filepath.Join(dir, game))
+		}
+
+		for _, p := range paths {
+			p = filepath.Clean(p)
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+
+			if _, err := os.Stat(p); err != nil {
+				// The ROM set itself may not exist if it's merged
+				// into the parent, otherwise everything is optional
+				if p == path {
+					missing = err
+				}
+				continue
+			}
+
+			s, err := idx.source(p)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, s)
+		}
+	}
+
+	if len(sources) == 0 && missing != nil {
+		return nil, missing
+	}
+
+	return sources, nil
+}
+
+// Clones returns the path of each clone of the game at path that could be
+// converted using only the ROM images found in its sources, such as a merged
+// ROM set containing the clones as well as the parent. Each path can be
+// passed to NewFile even if nothing exists there
+func (idx *Index) Clones(path string) ([]string, error) {
+	path = filepath.Clean(path)
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	var clones []string
+	for game, g := range mameGames {
+		if g.parent == name {
+			clones = append(clones, game)
+		}
+	}
+	sort.Strings(clones)
+
+	var paths []string
+	for _, clone := range clones {
+		p := filepath.Join(filepath.Dir(path), clone+filepath.Ext(path))
+
+		sources, err := idx.gameSources(p, clone)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasROMs(mameGames[clone].mameGame, sources) {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths, nil
+}
+
+// hasROMs reports whether every ROM image of the game that was dumped can
+// be found in the sources
+func hasROMs(g mameGame, sources []*sourceIndex) bool {
+	for i := 0; i < Areas; i++ {
+	next:
+		for _, mr := range g.area[i].rom {
+			if mr.nodump() {
+				continue
+			}
+			for _, s := range sources {
+				if _, ok := s.findCRC(mr.crc); ok {
+					continue next
+				}
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// sourceReaders opens each indexed source on first use
+type sourceReaders struct {
+	sources []*sourceIndex
+	readers []romSource
+	closers []func() error
+}
+
+func newSourceReaders(sources ...*sourceIndex) *sourceReaders {
+	return &sourceReaders{
+		sources: sources,
+		readers: make([]romSource, len(sources)),
+	}
+}
+
+func (sr *sourceReaders) open(source int, file string) (io.Reader, error) {
+	if sr.readers[source] == nil {
+		r, err := sr.sources[source].open()
+		if err != nil {
+			return nil, err
+		}
+		sr.readers[source] = r
+		sr.closers = append(sr.closers, r.Close)
+	}
+
+	rc, err := sr.readers[source].Open(file)
+	if err != nil {
+		return nil, err
+	}
+	sr.closers = append(sr.closers, rc.Close)
+
+	return rc, nil
+}
+
+// openFile opens a file on disk that isn't part of any source and returns
+// its size
+func (sr *sourceReaders) openFile(path string) (io.Reader, uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	sr.closers = append(sr.closers, file.Close)
+
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return file, uint64(fi.Size()), nil
+}
+
+// Close closes every file and source that was opened
+func (sr *sourceReaders) Close() error {
+	var err error
+	for i := len(sr.closers) - 1; i >= 0; i-- {
+		if e := sr.closers[i](); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
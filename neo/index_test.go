@@ -0,0 +1,149 @@
+package neo
+
+import (
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingSource counts the checksums computed by a romSource
+type countingSource struct {
+	romSource
+	crcs *int
+}
+
+func (s countingSource) CRC(file string) ([]byte, error) {
+	*s.crcs++
+	return s.romSource.CRC(file)
+}
+
+func TestSourceIndex(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.p1":    &fstest.MapFile{Data: []byte("program")},
+		"b.c1":    &fstest.MapFile{Data: []byte("sprites")},
+		"copy.p1": &fstest.MapFile{Data: []byte("program")},
+	}
+
+	var opens, crcs int
+	s, err := newSourceIndex("test", func() (romSource, error) {
+		opens++
+		r, err := openFS(fsys)
+		return countingSource{r, &crcs}, err
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	// Every file is checksummed once when indexing
+	assert.Equal(t, 1, opens)
+	assert.Equal(t, len(fsys), crcs)
+	assert.Len(t, s.files, len(fsys))
+
+	crc := func(b []byte) []byte {
+		c := crc32.ChecksumIEEE(b)
+		return []byte{byte(c >> 24), byte(c >> 16), byte(c >> 8), byte(c)}
+	}
+
+	// The first of any duplicates is found
+	file, ok := s.findCRC(crc([]byte("program")))
+	assert.True(t, ok)
+	assert.Equal(t, indexedFile{"a.p1", 7, crc([]byte("program"))}, file)
+
+	file, ok = s.findCRC(crc([]byte("sprites")))
+	assert.True(t, ok)
+	assert.Equal(t, "b.c1", file.name)
+
+	_, ok = s.findCRC(crc([]byte("missing")))
+	assert.False(t, ok)
+	assert.Equal(t, len(fsys), crcs)
+
+	// Sources are shared by path
+	idx := NewIndex()
+	seedSource(idx, "roms/maglord", s)
+	again, err := idx.source("roms/./maglord")
+	assert.Nil(t, err)
+	assert.Same(t, s, again)
+}
+
+func TestIndexRompath(t *testing.T) {
+	dir := t.TempDir()
+	for _, p := range []string{"roms1/maglord", "roms2/maglordh", "roms2/maglord", "sets"} {
+		assert.Nil(t, os.MkdirAll(filepath.Join(dir, p), 0777))
+	}
+
+	path := filepath.Join(dir, "sets", "maglordh.zip")
+
+	// The game is searched for in each directory, then its parent
+	idx := NewIndex(filepath.Join(dir, "roms1"), filepath.Join(dir, "roms2"))
+	sources, err := idx.gameSources(path, "maglordh")
+	assert.Nil(t, err)
+
+	var paths []string
+	for _, s := range sources {
+		paths = append(paths, s.path)
+	}
+	assert.Equal(t, []string{
+		filepath.Join(dir, "roms2", "maglordh"),
+		filepath.Join(dir, "roms1", "maglord"),
+		filepath.Join(dir, "roms2", "maglord"),
+	}, paths)
+
+	// Sources are only indexed once
+	again, err := idx.gameSources(path, "maglordh")
+	assert.Nil(t, err)
+	assert.Equal(t, sources, again)
+
+	_, err = NewIndex().gameSources(path, "maglordh")
+	assert.True(t, os.IsNotExist(err))
+}
+
+// seedSource indexes path as s without reading anything
+func seedSource(idx *Index, path string, s *sourceIndex) {
+	e := new(indexEntry)
+	e.once.Do(func() {
+		e.sourceIndex = s
+	})
+	idx.sources[filepath.Clean(path)] = e
+}
+
+func TestIndexClones(t *testing.T) {
+	defer copyGames()()
+
+	// A clone with a ROM image that was never dumped
+	g := mameGames["maglordh"]
+	g.area[C].rom = append(append([]mameROM(nil), g.area[C].rom...), mameROM{filename: "nodump.c3", size: 0x100000})
+	mameGames["maglordnd"] = g
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "maglord.zip")
+	assert.Nil(t, ioutil.WriteFile(path, nil, 0666))
+
+	tables := []struct {
+		games  []string
+		clones []string
+	}{
+		{[]string{"maglord"}, nil},
+		{[]string{"maglord", "maglordh"}, []string{"maglordh", "maglordnd"}},
+	}
+
+	for _, table := range tables {
+		idx := NewIndex()
+		seedSource(idx, path, sourceFor(table.games...))
+
+		clones, err := idx.Clones(path)
+		assert.Nil(t, err)
+
+		var names []string
+		for _, clone := range clones {
+			assert.Equal(t, dir, filepath.Dir(clone))
+			names = append(names, strings.TrimSuffix(filepath.Base(clone), ".zip"))
+		}
+		assert.Equal(t, table.clones, names)
+	}
+}
@@ -55,9 +55,16 @@ type mameROM struct {
 	crc      []byte
 }
 
+// nodump reports whether MAME has no dump of the ROM image and so no
+// checksum
+func (r mameROM) nodump() bool {
+	return r.crc == nil
+}
+
 type mameArea struct {
 	size uint64
 	rom  []mameROM
+	fill uint8 // Value MAME fills the area with, used for ROM images never dumped
 }
 
 func (a mameArea) padSize() uint64 {
@@ -70,6 +77,29 @@ func (a mameArea) padSize() uint64 {
 	return pad
 }
 
+// paddedSize returns the number of bytes commonPaddedReader reads for the
+// area
+func (a mameArea) paddedSize() uint64 {
+	if len(a.rom) == 0 {
+		return 0
+	}
+	return uint64(len(a.rom)-1)*a.padSize() + a.rom[len(a.rom)-1].size
+}
+
+// interleavedSize returns the number of bytes commonCReader reads for the
+// area
+func (a mameArea) interleavedSize() uint64 {
+	n := len(a.rom) &^ 1
+	if n == 0 {
+		return 0
+	}
+	size := a.rom[n-2].size
+	if a.rom[n-1].size > size {
+		size = a.rom[n-1].size
+	}
+	return uint64(n-2)*a.padSize() + size*2
+}
+
 type mameGame struct {
 	parent string
 	area   [Areas]mameArea
@@ -146,7 +176,7 @@ func smaPReader(a mameArea, readers []io.Reader) ([]uint16, error) {
 	return rom, nil
 }
 
-func commonCReader(a mameArea, readers []io.Reader) ([]byte, error) {
+func commonCReader(t *tracker, a mameArea, readers []io.Reader) ([]byte, error) {
 	var intermediates []io.Reader
 
 	for i := 0; i < len(readers); i += 2 {
@@ -157,20 +187,29 @@ func commonCReader(a mameArea, readers []io.Reader) ([]byte, error) {
 
 		if i < len(readers)-2 {
 			intermediate = plumbing.PaddedReader(intermediate, int64(a.padSize()*2), 0)
+
+			size := a.rom[i].size
+			if a.rom[i+1].size > size {
+				size = a.rom[i+1].size
+			}
+			t.pad(C, uint64(i)*a.padSize()+size*2, (a.padSize()-size)*2)
 		}
 
 		intermediates = append(intermediates, intermediate)
+
+		t.report(C, StageInterleave, uint64(i+2)*a.padSize(), uint64(len(readers))*a.padSize())
 	}
 
 	return ioutil.ReadAll(io.MultiReader(intermediates...))
 }
 
-func commonPaddedReader(a mameArea, readers []io.Reader) ([]byte, error) {
+func commonPaddedReader(t *tracker, area int, a mameArea, readers []io.Reader) ([]byte, error) {
 	padded := make([]io.Reader, len(readers))
 
 	for i, r := range readers {
 		if i < len(readers)-1 {
 			r = plumbing.PaddedReader(r, int64(a.padSize()), 0)
+			t.pad(area, uint64(i)*a.padSize()+a.rom[i].size, a.padSize()-a.rom[i].size)
 		}
 		padded[i] = r
 	}
@@ -189,14 +228,14 @@ func commonCMC42Reader(f *File, g mameGame, readers [][]io.Reader, xor int) erro
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, xor)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, xor)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -215,20 +254,20 @@ func commonCMC50Reader(f *File, g mameGame, readers [][]io.Reader, xor int) erro
 		case S:
 			break
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, xor)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, xor)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -248,32 +287,32 @@ func commonPCM2Reader(f *File, g mameGame, readers [][]io.Reader, xor int, decry
 			if decryptSfix {
 				break
 			}
-			if f.ROM[S], err = commonPaddedReader(g.area[S], readers[S]); err != nil {
+			if f.ROM[S], err = commonPaddedReader(f.tracker, S, g.area[S], readers[S]); err != nil {
 				return err
 			}
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case V1:
-			b, err := commonPaddedReader(g.area[V1], readers[V1])
+			b, err := commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1])
 			if err != nil {
 				return err
 			}
-			f.ROM[V1] = pcm2Decrypt(b, value)
+			f.ROM[V1] = pcm2Decrypt(f.tracker, b, value)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, xor)
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, xor)
 			if decryptSfix {
-				f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+				f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -347,26 +386,26 @@ func commonPVCReader(f *File, g mameGame, readers [][]io.Reader, xor1, xor2 [0x2
 		case S:
 			break
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case V1:
-			b, err := commonPaddedReader(g.area[V1], readers[V1])
+			b, err := commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1])
 			if err != nil {
 				return err
 			}
-			f.ROM[V1] = pcm2Swap(b, value)
+			f.ROM[V1] = pcm2Swap(f.tracker, b, value)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, xor)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, xor)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -410,32 +449,32 @@ func commonK2K2Reader(f *File, g mameGame, readers [][]io.Reader, xor int, decry
 			if decryptSfix {
 				break
 			}
-			if f.ROM[S], err = commonPaddedReader(g.area[S], readers[S]); err != nil {
+			if f.ROM[S], err = commonPaddedReader(f.tracker, S, g.area[S], readers[S]); err != nil {
 				return err
 			}
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case V1:
-			b, err := commonPaddedReader(g.area[V1], readers[V1])
+			b, err := commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1])
 			if err != nil {
 				return err
 			}
-			f.ROM[V1] = pcm2Swap(b, value)
+			f.ROM[V1] = pcm2Swap(f.tracker, b, value)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, xor)
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, xor)
 			if decryptSfix {
-				f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+				f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -445,7 +484,7 @@ func commonK2K2Reader(f *File, g mameGame, readers [][]io.Reader, xor int, decry
 
 // unsupported explicitly errors
 func unsupported(f *File, g mameGame, readers [][]io.Reader) error {
-	return errUnsupported
+	return ErrUnsupported
 }
 
 // common handles the majority of games
@@ -458,11 +497,11 @@ func common(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -513,8 +552,8 @@ func cthdPReader(a mameArea, readers []io.Reader) ([]byte, error) {
 	return b, nil
 }
 
-func cthdMReader(a mameArea, readers []io.Reader) ([]byte, error) {
-	b, err := commonPaddedReader(a, readers)
+func cthdMReader(t *tracker, a mameArea, readers []io.Reader) ([]byte, error) {
+	b, err := commonPaddedReader(t, M, a, readers)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +577,7 @@ func cthd2003(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case S:
-			if f.ROM[S], err = commonPaddedReader(g.area[S], readers[S]); err != nil {
+			if f.ROM[S], err = commonPaddedReader(f.tracker, S, g.area[S], readers[S]); err != nil {
 				return err
 			}
 			tmp := make([]byte, 0x20000)
@@ -548,17 +587,17 @@ func cthd2003(f *File, g mameGame, readers [][]io.Reader) error {
 			copy(tmp[0x18000:0x20000], f.ROM[S][0x18000:])
 			copy(f.ROM[S], tmp)
 		case M:
-			if f.ROM[M], err = cthdMReader(g.area[M], readers[M]); err != nil {
+			if f.ROM[M], err = cthdMReader(f.tracker, g.area[M], readers[M]); err != nil {
 				return err
 			}
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
 			f.ROM[C] = cthdDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -576,17 +615,17 @@ func ct2k3sa(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case M:
-			if f.ROM[M], err = cthdMReader(g.area[M], readers[M]); err != nil {
+			if f.ROM[M], err = cthdMReader(f.tracker, g.area[M], readers[M]); err != nil {
 				return err
 			}
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
 			f.ROM[C] = cthdDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -604,7 +643,7 @@ func ct2k3sp(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
@@ -619,17 +658,17 @@ func ct2k3sp(f *File, g mameGame, readers [][]io.Reader) error {
 			copy(f.ROM[S][0x28000:0x30000], b[0x30000:])
 			copy(f.ROM[S][0x30000:0x38000], b[0x28000:])
 		case M:
-			if f.ROM[M], err = cthdMReader(g.area[M], readers[M]); err != nil {
+			if f.ROM[M], err = cthdMReader(f.tracker, g.area[M], readers[M]); err != nil {
 				return err
 			}
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
 			f.ROM[C] = cthdDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -651,11 +690,11 @@ func dragonsh(f *File, g mameGame, readers [][]io.Reader) error {
 		case V1:
 			f.ROM[V1] = bytes.Repeat([]byte{0xff}, twoMB)
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -674,11 +713,11 @@ func fightfeva(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -722,14 +761,14 @@ func garou(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, garouGfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, garouGfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -747,19 +786,19 @@ func garoubl(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
 			f.ROM[S] = sxDecrypt(b, 2)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
 			f.ROM[C] = cxDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -798,14 +837,14 @@ func garouh(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, garouGfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, garouGfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -840,7 +879,7 @@ func gpilotsp(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -884,20 +923,20 @@ func kof2000(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, kof2000GfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, kof2000GfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -940,11 +979,11 @@ func kof95a(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -973,7 +1012,7 @@ func kof97oro(f *File, g mameGame, readers [][]io.Reader) error {
 				copy(f.ROM[P][i*2:(i+1)*2], b[(i^0x7ffef)*2:])
 			}
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
@@ -995,7 +1034,7 @@ func kof97oro(f *File, g mameGame, readers [][]io.Reader) error {
 
 			f.ROM[C] = cxDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1046,11 +1085,11 @@ func kof98(f *File, g mameGame, readers [][]io.Reader) error {
 
 			f.ROM[P] = b
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1089,14 +1128,14 @@ func kof99(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, kof99GfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, kof99GfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1141,7 +1180,7 @@ func kotm2(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1190,7 +1229,7 @@ func kotm2p(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1245,26 +1284,26 @@ func lans2004(f *File, g mameGame, readers [][]io.Reader) error {
 
 			f.ROM[P] = uint16SliceToBytes(rom)
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
 			f.ROM[S] = sxDecrypt(b, 1)
 		case V1:
-			if f.ROM[V1], err = commonPaddedReader(g.area[V1], readers[V1]); err != nil {
+			if f.ROM[V1], err = commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1]); err != nil {
 				return err
 			}
 			for i := 0; i < 0xa00000; i++ {
 				f.ROM[V1][i] = bitswapByte(f.ROM[V1][i], 0, 1, 5, 4, 3, 2, 6, 7)
 			}
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
 			f.ROM[C] = cxDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1293,7 +1332,7 @@ func matrimbl(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
@@ -1328,7 +1367,7 @@ func matrimbl(f *File, g mameGame, readers [][]io.Reader) error {
 			}
 			f.ROM[M] = rom[:0x20000]
 		case V1:
-			if f.ROM[V1], err = commonPaddedReader(g.area[V1], readers[V1]); err != nil {
+			if f.ROM[V1], err = commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1]); err != nil {
 				return err
 			}
 			// XXX Not sure why I have to byteswap this?
@@ -1340,14 +1379,14 @@ func matrimbl(f *File, g mameGame, readers [][]io.Reader) error {
 				f.ROM[V1][i+0], f.ROM[V1][i+1] = f.ROM[V1][i+1], f.ROM[V1][i+0]
 			}
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[S] = cmcSfixDecrypt(b, int(g.area[S].size))
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, b, int(g.area[S].size))
 			f.ROM[C] = cthdDecrypt(b)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1386,14 +1425,14 @@ func mslug3(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, mslug3GfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, mslug3GfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1432,14 +1471,14 @@ func mslug3a(f *File, g mameGame, readers [][]io.Reader) error {
 		case S:
 			break
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, mslug3GfxKey)
-			f.ROM[S] = cmcSfixDecrypt(f.ROM[C], int(g.area[S].size))
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, mslug3GfxKey)
+			f.ROM[S] = cmcSfixDecrypt(f.tracker, f.ROM[C], int(g.area[S].size))
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1458,19 +1497,19 @@ func mslug3b6(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
 			f.ROM[S] = sxDecrypt(b, 2)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc42GfxDecrypt(b, mslug3GfxKey)
+			f.ROM[C] = cmc42GfxDecrypt(f.tracker, b, mslug3GfxKey)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1498,31 +1537,31 @@ func ms5plus(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case S:
-			b, err := commonPaddedReader(g.area[S], readers[S])
+			b, err := commonPaddedReader(f.tracker, S, g.area[S], readers[S])
 			if err != nil {
 				return err
 			}
 			f.ROM[S] = sxDecrypt(b, 1)
 		case M:
-			b, err := commonPaddedReader(g.area[M], readers[M])
+			b, err := commonPaddedReader(f.tracker, M, g.area[M], readers[M])
 			if err != nil {
 				return err
 			}
-			f.ROM[M] = cmc50M1Decrypt(b)
+			f.ROM[M] = cmc50M1Decrypt(f.tracker, b)
 		case V1:
-			b, err := commonPaddedReader(g.area[V1], readers[V1])
+			b, err := commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1])
 			if err != nil {
 				return err
 			}
-			f.ROM[V1] = pcm2Swap(b, 2)
+			f.ROM[V1] = pcm2Swap(f.tracker, b, 2)
 		case C:
-			b, err := commonCReader(g.area[C], readers[C])
+			b, err := commonCReader(f.tracker, g.area[C], readers[C])
 			if err != nil {
 				return err
 			}
-			f.ROM[C] = cmc50GfxDecrypt(b, mslug5GfxKey)
+			f.ROM[C] = cmc50GfxDecrypt(f.tracker, b, mslug5GfxKey)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1555,17 +1594,17 @@ func pbobblenb(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		case C:
-			if f.ROM[C], err = commonCReader(g.area[C], readers[C]); err != nil {
+			if f.ROM[C], err = commonCReader(f.tracker, g.area[C], readers[C]); err != nil {
 				return err
 			}
 		case V1:
-			b, err := commonPaddedReader(g.area[V1], readers[V1])
+			b, err := commonPaddedReader(f.tracker, V1, g.area[V1], readers[V1])
 			if err != nil {
 				return err
 			}
 			f.ROM[V1] = append(bytes.Repeat([]byte{0}, twoMB), b...)
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
@@ -1645,7 +1684,7 @@ func viewpoin(f *File, g mameGame, readers [][]io.Reader) error {
 				return err
 			}
 		default:
-			if f.ROM[i], err = commonPaddedReader(g.area[i], readers[i]); err != nil {
+			if f.ROM[i], err = commonPaddedReader(f.tracker, i, g.area[i], readers[i]); err != nil {
 				return err
 			}
 		}
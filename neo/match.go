@@ -0,0 +1,95 @@
+package neo
+
+import (
+	"path"
+	"strings"
+)
+
+// romMatch is the file found for a ROM image
+type romMatch struct {
+	source int
+	file   indexedFile
+	by     string // How the file was matched if not by checksum
+}
+
+// matchROMs finds each ROM image of the game in the sources by checksum,
+// any that can't be found or were never dumped are nil. If allowMismatch is set then any file not
+// already used that has the same filename as a missing ROM image or failing
+// that the same size and appears to belong to the same area is used instead
+func matchROMs(g mameGame, sources []*sourceIndex, allowMismatch bool) [Areas][]*romMatch {
+	var matches [Areas][]*romMatch
+
+	type key struct {
+		source int
+		name   string
+	}
+	used := make(map[key]bool)
+
+	for i := 0; i < Areas; i++ {
+		matches[i] = make([]*romMatch, len(g.area[i].rom))
+		for j, mr := range g.area[i].rom {
+			if mr.nodump() {
+				continue
+			}
+			for k, s := range sources {
+				if file, ok := s.findCRC(mr.crc); ok {
+					matches[i][j] = &romMatch{source: k, file: file}
+					used[key{k, file.name}] = true
+					break
+				}
+			}
+		}
+	}
+
+	if !allowMismatch {
+		return matches
+	}
+
+	fallbacks := []struct {
+		by    string
+		match func(int, mameROM, indexedFile) bool
+	}{
+		{"filename", func(_ int, mr mameROM, file indexedFile) bool {
+			return strings.EqualFold(path.Base(file.name), mr.filename)
+		}},
+		{"size", func(area int, mr mameROM, file indexedFile) bool {
+			a, ok := filenameArea(path.Base(file.name))
+			return ok && a == area && file.size == mr.size
+		}},
+	}
+
+	for _, fallback := range fallbacks {
+		for i := 0; i < Areas; i++ {
+			for j, mr := range g.area[i].rom {
+				if matches[i][j] != nil || mr.nodump() {
+					continue
+				}
+			search:
+				for k, s := range sources {
+					for _, file := range s.files {
+						if !used[key{k, file.name}] && fallback.match(i, mr, file) {
+							matches[i][j] = &romMatch{source: k, file: file, by: fallback.by}
+							used[key{k, file.name}] = true
+							break search
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+// findFilename finds a file in the sources with the same name as a ROM
+// image regardless of its checksum
+func findFilename(sources []*sourceIndex, filename string) (int, indexedFile, bool) {
+	for i, s := range sources {
+		for _, file := range s.files {
+			if strings.EqualFold(path.Base(file.name), filename) {
+				return i, file, true
+			}
+		}
+	}
+	return 0, indexedFile{}, false
+}
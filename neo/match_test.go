@@ -0,0 +1,35 @@
+package neo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchROMs(t *testing.T) {
+	g := mameGames["maglord"].mameGame
+
+	s := sourceFor("maglord")
+	s.files[1].crc = []byte{0xde, 0xad, 0xbe, 0xef} // 005-s1.s1
+	s.files[2].name = "bad.m1"                      // 005-m1.m1
+	s.files[2].crc = []byte{0xde, 0xad, 0xbe, 0xef}
+	s.crc = map[string]int{}
+	for i, file := range s.files {
+		s.crc[string(file.crc)] = i
+	}
+
+	matches := matchROMs(g, []*sourceIndex{s}, false)
+	assert.NotNil(t, matches[P][0])
+	assert.Nil(t, matches[S][0])
+	assert.Nil(t, matches[M][0])
+
+	matches = matchROMs(g, []*sourceIndex{s}, true)
+	if assert.NotNil(t, matches[S][0]) {
+		assert.Equal(t, "filename", matches[S][0].by)
+	}
+	if assert.NotNil(t, matches[M][0]) {
+		assert.Equal(t, "size", matches[M][0].by)
+		assert.Equal(t, "bad.m1", matches[M][0].file.name)
+	}
+	assert.Equal(t, "", matches[C][0].by)
+}
@@ -0,0 +1,42 @@
+package neo
+
+import (
+	"fmt"
+)
+
+// MissingROM describes a ROM image that could not be found
+type MissingROM struct {
+	Game     string   `json:"game"`   // Game the ROM image belongs to
+	Parent   bool     `json:"parent"` // Whether Game is an ancestor of the game being converted
+	Area     int      `json:"area"`
+	Filename string   `json:"filename"`
+	Size     uint64   `json:"size"`
+	CRC      Checksum `json:"crc"`
+}
+
+// MissingROMError lists every ROM image of a game that could not be found
+type MissingROMError struct {
+	Game string
+	ROMs []MissingROM
+}
+
+func (e *MissingROMError) Error() string {
+	if len(e.ROMs) == 1 {
+		return fmt.Sprintf("neo: %s not found for %s", e.ROMs[0].Filename, e.Game)
+	}
+	return fmt.Sprintf("neo: %d ROMs not found for %s", len(e.ROMs), e.Game)
+}
+
+// romOwner returns the oldest ancestor of the game that also uses the ROM
+// image, which is where a split ROM set would keep it
+func romOwner(game string, crc []byte) string {
+	owner := game
+	seen := map[string]bool{game: true}
+	for name := mameGames[game].parent; name != "" && !seen[name]; name = mameGames[name].parent {
+		seen[name] = true
+		if _, ok := gameCRCs(name)[string(crc)]; ok {
+			owner = name
+		}
+	}
+	return owner
+}
@@ -0,0 +1,27 @@
+package neo
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingROMError(t *testing.T) {
+	_, err := NewFileFS(fstest.MapFS{}, "maglordh.zip")
+
+	var merr *MissingROMError
+	if assert.True(t, errors.As(err, &merr)) {
+		assert.Equal(t, "maglordh", merr.Game)
+		assert.Len(t, merr.ROMs, 12)
+
+		assert.Equal(t, P, merr.ROMs[0].Area)
+		assert.Equal(t, "maglordh", merr.ROMs[0].Game)
+		assert.False(t, merr.ROMs[0].Parent)
+
+		assert.Equal(t, S, merr.ROMs[1].Area)
+		assert.Equal(t, "maglord", merr.ROMs[1].Game)
+		assert.True(t, merr.ROMs[1].Parent)
+	}
+}
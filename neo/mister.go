@@ -0,0 +1,159 @@
+package neo
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// Indexes the MiSTer Neo Geo core loads each ROM image at. The C ROM
+// images are loaded in odd and even pairs from misterCIndex
+const (
+	misterPIndex  = 4
+	misterP2Index = 6
+	misterSIndex  = 8
+	misterMIndex  = 9
+	misterV1Index = 16
+	misterV2Index = 48
+	misterCIndex  = 64
+)
+
+// misterCSize is the largest C ROM image written, the pairs are
+// interleaved by the core so each holds every other byte
+const misterCSize = 4 * oneMB
+
+// misterImage is a ROM image in the layout read by the MiSTer Neo Geo core
+type misterImage struct {
+	name  string
+	index int
+	b     []byte
+}
+
+// misterImages returns the non-empty ROM areas split into the ROM images
+// read by the MiSTer Neo Geo core. Any P ROM larger than 1 MB is split so
+// the bankswitched part is in p2 and the C ROM is split back into pairs of
+// ROM images each holding every other byte
+func (f *File) misterImages() []misterImage {
+	var images []misterImage
+
+	p, p2 := f.ROM[P], []byte(nil)
+	if len(p) > oneMB {
+		p, p2 = p[:oneMB], p[oneMB:]
+	}
+
+	for _, image := range []misterImage{
+		{"p1.p1", misterPIndex, p},
+		{"p2.p2", misterP2Index, p2},
+		{"s1.s1", misterSIndex, f.ROM[S]},
+		{"m1.m1", misterMIndex, f.ROM[M]},
+		{"v1.v1", misterV1Index, f.ROM[V1]},
+		{"v2.v2", misterV2Index, f.ROM[V2]},
+	} {
+		if len(image.b) > 0 {
+			images = append(images, image)
+		}
+	}
+
+	a := mameArea{size: uint64(len(f.ROM[C]))}
+	for offset := uint64(0); offset < a.size; offset += 2 * misterCSize {
+		size := (a.size - offset + 1) / 2
+		if size > misterCSize {
+			size = misterCSize
+		}
+		for i := 0; i < 2; i++ {
+			a.rom = append(a.rom, mameROM{
+				filename: fmt.Sprintf("c%d.c%d", len(a.rom)+1, len(a.rom)+1),
+				size:     size,
+			})
+		}
+	}
+
+	for i, b := range commonCSplit(a, f.ROM[C]) {
+		images = append(images, misterImage{a.rom[i].filename, misterCIndex + i, b})
+	}
+
+	return images
+}
+
+// pcm reports whether the game has a single V ROM shared by both sound
+// channels, as with the NEO-PCM2 chip and the CMC protected games
+func (f *File) pcm() bool {
+	return len(f.ROM[V1]) > 0 && len(f.ROM[V2]) == 0
+}
+
+// MiSTerFile is a ROM image listed in a MiSTer romset entry
+type MiSTerFile struct {
+	Name  string `xml:"name,attr"`
+	Index int    `xml:"index,attr"`
+	Size  string `xml:"size,attr,omitempty"`
+}
+
+// MiSTerRomSet is an entry in the romsets.xml file read by the MiSTer Neo
+// Geo core. Any attributes not listed are kept in Attr
+type MiSTerRomSet struct {
+	Name      string       `xml:"name,attr"`
+	AltName   string       `xml:"altname,attr,omitempty"`
+	Publisher string       `xml:"publisher,attr,omitempty"`
+	Year      string       `xml:"year,attr,omitempty"`
+	Attr      []xml.Attr   `xml:",any,attr"`
+	Files     []MiSTerFile `xml:"file"`
+}
+
+// MiSTerRomSets is the romsets.xml file read by the MiSTer Neo Geo core
+type MiSTerRomSets struct {
+	XMLName xml.Name       `xml:"romsets"`
+	RomSets []MiSTerRomSet `xml:"romset"`
+}
+
+// Add adds rs to the list of romsets, replacing any existing entry with the
+// same name
+func (m *MiSTerRomSets) Add(rs MiSTerRomSet) {
+	for i := range m.RomSets {
+		if m.RomSets[i].Name == rs.Name {
+			m.RomSets[i] = rs
+			return
+		}
+	}
+	m.RomSets = append(m.RomSets, rs)
+}
+
+// MiSTerRomSet returns the romsets.xml entry for the ROM images written by
+// ExportMiSTer to a directory called name. Games with a single V ROM are
+// marked as using the PCM chip
+func (f *File) MiSTerRomSet(name string) MiSTerRomSet {
+	rs := MiSTerRomSet{
+		Name:      name,
+		AltName:   f.Name,
+		Publisher: f.Manufacturer,
+	}
+	if f.Year != 0 {
+		rs.Year = strconv.FormatUint(uint64(f.Year), 10)
+	}
+
+	if f.pcm() {
+		rs.Attr = append(rs.Attr, xml.Attr{Name: xml.Name{Local: "pcm"}, Value: "1"})
+	}
+
+	for _, image := range f.misterImages() {
+		rs.Files = append(rs.Files, MiSTerFile{
+			Name:  image.name,
+			Index: image.index,
+			Size:  fmt.Sprintf("0x%x", len(image.b)),
+		})
+	}
+
+	return rs
+}
+
+// ExportMiSTer writes the non-empty ROM areas to the directory dir using the
+// filenames and layout read by the MiSTer Neo Geo core, creating the
+// directory if necessary. The core also needs the entry returned by
+// MiSTerRomSet adding to its romsets.xml file
+func (f *File) ExportMiSTer(dir string) error {
+	var images []darksoftImage
+	for _, image := range f.misterImages() {
+		images = append(images, darksoftImage{image.name, image.b})
+	}
+
+	return writeImages(dir, images)
+}
@@ -0,0 +1,91 @@
+package neo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiSTerRomSet(t *testing.T) {
+	f := new(File)
+	f.Name, f.Manufacturer, f.Year = "Magician Lord", "Alpha Denshi Co.", 1990
+	f.ROM[P] = make([]byte, 0x180000)
+	f.ROM[S] = make([]byte, 0x20000)
+	f.ROM[M] = make([]byte, 0x40000)
+	f.ROM[V1] = make([]byte, 0x80000)
+	f.ROM[V2] = make([]byte, 0x80000)
+	f.ROM[C] = make([]byte, 0x300000)
+
+	m := new(MiSTerRomSets)
+	m.Add(MiSTerRomSet{Name: "maglord", Attr: []xml.Attr{{Name: xml.Name{Local: "pcm"}, Value: "1"}}})
+	m.Add(f.MiSTerRomSet("maglord"))
+
+	b, err := xml.Marshal(m)
+	assert.Nil(t, err)
+	assert.Equal(t, `<romsets><romset name="maglord" altname="Magician Lord" publisher="Alpha Denshi Co." year="1990"><file name="p1.p1" index="4" size="0x100000"></file><file name="p2.p2" index="6" size="0x80000"></file><file name="s1.s1" index="8" size="0x20000"></file><file name="m1.m1" index="9" size="0x40000"></file><file name="v1.v1" index="16" size="0x80000"></file><file name="v2.v2" index="48" size="0x80000"></file><file name="c1.c1" index="64" size="0x180000"></file><file name="c2.c2" index="65" size="0x180000"></file></romset></romsets>`, string(b))
+
+	// A single V ROM is shared by both sound channels
+	f.ROM[V2] = nil
+	rs := f.MiSTerRomSet("maglord")
+	assert.Equal(t, []xml.Attr{{Name: xml.Name{Local: "pcm"}, Value: "1"}}, rs.Attr)
+	assert.Equal(t, MiSTerFile{Name: "v1.v1", Index: 16, Size: "0x80000"}, rs.Files[4])
+	assert.Equal(t, MiSTerFile{Name: "c1.c1", Index: 64, Size: "0x180000"}, rs.Files[5])
+
+	n := new(MiSTerRomSets)
+	assert.Nil(t, xml.Unmarshal([]byte(`<romsets><romset name="mslug" pcm="1"><file name="prom" index="4"/></romset></romsets>`), n))
+	assert.Equal(t, []xml.Attr{{Name: xml.Name{Local: "pcm"}, Value: "1"}}, n.RomSets[0].Attr)
+}
+
+func TestExportMiSTer(t *testing.T) {
+	f := testFile(0x200)
+	f.ROM[P] = append(bytes.Repeat([]byte{0xaa}, oneMB), f.ROM[P]...)
+	f.ROM[V2] = nil
+	f.ROM[C] = make([]byte, 20*oneMB)
+	for i := range f.ROM[C] {
+		f.ROM[C][i] = byte(i) ^ byte(i>>20)
+	}
+
+	dir := t.TempDir()
+	assert.Nil(t, f.ExportMiSTer(dir))
+
+	rs := f.MiSTerRomSet("test")
+	assert.Equal(t, []xml.Attr{{Name: xml.Name{Local: "pcm"}, Value: "1"}}, rs.Attr)
+
+	infos, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, infos, len(rs.Files))
+
+	files := make(map[int][]byte)
+	for _, file := range rs.Files {
+		b, err := ioutil.ReadFile(filepath.Join(dir, file.Name))
+		if assert.Nil(t, err, file.Name) {
+			files[file.Index] = b
+		}
+	}
+
+	assert.Equal(t, f.ROM[P][:oneMB], files[4])
+	assert.Equal(t, f.ROM[P][oneMB:], files[6])
+	assert.Equal(t, f.ROM[S], files[8])
+	assert.Equal(t, f.ROM[M], files[9])
+	assert.Equal(t, f.ROM[V1], files[16])
+	assert.NotContains(t, files, 48)
+
+	// The C ROM is split into 4 MB pairs with the odd and even bytes
+	// interleaved by the core
+	var c []byte
+	for i := 64; i < 70; i += 2 {
+		if assert.Len(t, files[i+1], len(files[i])) {
+			for j := range files[i] {
+				c = append(c, files[i][j], files[i+1][j])
+			}
+		}
+	}
+	assert.Len(t, files[64], 4*oneMB)
+	assert.Len(t, files[68], 2*oneMB)
+	assert.NotContains(t, files, 70)
+	assert.Equal(t, f.ROM[C], c)
+}
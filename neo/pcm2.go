@@ -2,7 +2,7 @@ package neo
 
 import "encoding/binary"
 
-func pcm2Decrypt(b []byte, value int) []byte {
+func pcm2Decrypt(t *tracker, b []byte, value int) []byte {
 	rom := make([]uint16, len(b)/2)
 	for i := range rom {
 		rom[i] = binary.LittleEndian.Uint16(b[i*2 : (i+1)*2])
@@ -11,16 +11,21 @@ func pcm2Decrypt(b []byte, value int) []byte {
 	buf := make([]uint16, value/2)
 
 	for i := 0; i < len(rom); i += value / 2 {
+		if i%progressInterval == 0 && !t.report(V1, StagePCM2Swap, uint64(i)*2, uint64(len(b))) {
+			break
+		}
 		copy(buf, rom[i:])
 		for j := 0; j < value/2; j++ {
 			rom[i+j] = buf[j^(value/4)]
 		}
 	}
 
+	t.report(V1, StagePCM2Swap, uint64(len(b)), uint64(len(b)))
+
 	return uint16SliceToBytes(rom)
 }
 
-func pcm2Swap(b []byte, value int) []byte {
+func pcm2Swap(t *tracker, b []byte, value int) []byte {
 	addrs := [7][2]uint32{
 		{0x000000, 0xa5000},
 		{0xffce20, 0x01000},
@@ -43,11 +48,16 @@ func pcm2Swap(b []byte, value int) []byte {
 	rom := make([]byte, 0x1000000)
 
 	for i := 0; i < 0x1000000; i++ {
+		if i%progressInterval == 0 && !t.report(V1, StagePCM2Swap, uint64(i), 0x1000000) {
+			return rom
+		}
 		j := bitswapInt(i, 23, 22, 21, 20, 19, 18, 17, 0, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 16)
 		j ^= int(addrs[value][1])
 		d := (i + int(addrs[value][0])) & 0xffffff
 		rom[j] = b[d] ^ xordata[value][j&0x07]
 	}
 
+	t.report(V1, StagePCM2Swap, 0x1000000, 0x1000000)
+
 	return rom
 }
@@ -0,0 +1,148 @@
+package neo
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// Stage is a step in converting a ROM area
+type Stage int
+
+// These constants are the stages reported while converting
+const (
+	StageRead Stage = iota
+	StageInterleave
+	StageGfxDecrypt
+	StageSfixExtract
+	StageM1Decrypt
+	StagePCM2Swap
+)
+
+func (s Stage) String() string {
+	switch s {
+	case StageRead:
+		return "read"
+	case StageInterleave:
+		return "interleave"
+	case StageGfxDecrypt:
+		return "gfx decrypt"
+	case StageSfixExtract:
+		return "sfix extract"
+	case StageM1Decrypt:
+		return "M1 decrypt"
+	case StagePCM2Swap:
+		return "PCM2 swap"
+	default:
+		return "stage " + strconv.Itoa(int(s))
+	}
+}
+
+// Progress describes how far a stage of converting an area has got
+type Progress struct {
+	Area  int
+	Stage Stage
+	Done  uint64
+	Total uint64
+}
+
+// Options change how a ROM set is converted
+type Options struct {
+	// Progress is called periodically during each stage of converting
+	// each area if set
+	Progress func(Progress)
+	// AllowMismatch uses a file with the same filename or failing that
+	// the same size in place of any ROM image that can't be found by its
+	// checksum, such as an older dump. The result can't be verified
+	AllowMismatch bool
+	// Scheme decrypts any ROM set not known to MAME instead of assuming
+	// it isn't encrypted
+	Scheme *Scheme
+	// Nodump maps the MAME filename of a ROM image that was never dumped
+	// to the path of a file to use in its place. Otherwise a file in the
+	// ROM set with the same filename is used or failing that the area's
+	// fill value
+	Nodump map[string]string
+}
+
+// progressInterval is roughly how many bytes are processed between each
+// progress report and check for cancellation
+const progressInterval = 0x10000
+
+// tracker reports progress, notices cancellation and records what was done
+// during a conversion. A nil tracker does none of these so the decoding
+// functions can always use one
+type tracker struct {
+	ctx    context.Context
+	opts   Options
+	result *ConversionReport
+}
+
+func newTracker(ctx context.Context, opts *Options) *tracker {
+	t := &tracker{
+		ctx:    ctx,
+		result: new(ConversionReport),
+	}
+	if opts != nil {
+		t.opts = *opts
+	}
+	return t
+}
+
+// pad records size bytes of padding added to an area at offset
+func (t *tracker) pad(area int, offset, size uint64) {
+	if t != nil {
+		t.result.pad(area, offset, size)
+	}
+}
+
+// report passes on the progress of a stage and returns false if the
+// conversion has been cancelled and the stage should give up early
+func (t *tracker) report(area int, stage Stage, done, total uint64) bool {
+	if t == nil {
+		return true
+	}
+	if t.opts.Progress != nil {
+		t.opts.Progress(Progress{area, stage, done, total})
+	}
+	return t.ctx.Err() == nil
+}
+
+// countingReader reports each read of a ROM image as progress through
+// reading the whole area
+type countingReader struct {
+	t     *tracker
+	r     io.Reader
+	area  int
+	done  *uint64
+	total uint64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	if err := cr.t.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := cr.r.Read(p)
+	*cr.done += uint64(n)
+	cr.t.report(cr.area, StageRead, *cr.done, cr.total)
+	return n, err
+}
+
+// wrap replaces each reader so that reading the ROM images is reported
+func (t *tracker) wrap(g mameGame, readers [][]io.Reader) {
+	if t == nil {
+		return
+	}
+
+	for i := range readers {
+		var total uint64
+		for _, r := range g.area[i].rom {
+			total += r.size
+		}
+
+		done := new(uint64)
+		for j, r := range readers[i] {
+			readers[i][j] = &countingReader{t, r, i, done, total}
+		}
+	}
+}
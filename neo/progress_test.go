@@ -0,0 +1,46 @@
+package neo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStageString(t *testing.T) {
+	assert.Equal(t, "read", StageRead.String())
+	assert.Equal(t, "PCM2 swap", StagePCM2Swap.String())
+	assert.Equal(t, "stage 42", Stage(42).String())
+}
+
+func TestConvertCancel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hack.zip")
+	b := new(bytes.Buffer)
+	assert.Nil(t, testFile(0x40000).ExportMAME(b, "not a game"))
+	assert.Nil(t, ioutil.WriteFile(path, b.Bytes(), 0666))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var progress []Progress
+	f, report, err := Convert(ctx, path, &Options{
+		Progress: func(p Progress) {
+			progress = append(progress, p)
+			// Part way through reading the V1 ROM
+			if p.Area == V1 && p.Done > 0 {
+				cancel()
+			}
+		},
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, f)
+	assert.Nil(t, report)
+
+	// Nothing after V1 was started
+	for _, p := range progress {
+		assert.True(t, p.Area <= V1)
+	}
+}
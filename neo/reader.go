@@ -0,0 +1,46 @@
+package neo
+
+import (
+	"io"
+)
+
+// Reader provides access to an existing .neo file without reading the ROM
+// areas into memory. Each area is only read when its section is used
+type Reader struct {
+	fileFields
+	Header
+	ROM [Areas]*io.SectionReader
+}
+
+// Open parses the header of the .neo file accessed through r and returns a
+// Reader with a section for each ROM area
+func Open(r io.ReaderAt) (*Reader, error) {
+	ff, err := readFileFields(io.NewSectionReader(r, 0, headerSize))
+	if err != nil {
+		return nil, err
+	}
+
+	nr := &Reader{
+		fileFields: ff,
+		Header:     ff.header(),
+	}
+
+	offset := int64(headerSize)
+	for i := 0; i < Areas; i++ {
+		nr.ROM[i] = io.NewSectionReader(r, offset, int64(nr.Size[i]))
+		offset += int64(nr.Size[i])
+	}
+
+	// Probe either side of where the data should end rather than reading it
+	b := make([]byte, 1)
+	if n, _ := r.ReadAt(b, offset-1); n != 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	// There should be no more data to read
+	if n, _ := r.ReadAt(b, offset); n != 0 {
+		return nil, errTooMuch
+	}
+
+	return nr, nil
+}
@@ -0,0 +1,76 @@
+package neo
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// These constants are the ways a ROM set can be converted
+const (
+	MethodMAME     = "mame"
+	MethodGeneric  = "generic"
+	MethodDarksoft = "darksoft"
+)
+
+// Checksum is a checksum that is shown in hex
+type Checksum []byte
+
+func (c Checksum) String() string {
+	return hex.EncodeToString(c)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (c Checksum) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// ROMSource records where a ROM image was found when converting a ROM set
+type ROMSource struct {
+	Area     int      `json:"area"`
+	Filename string   `json:"filename"`           // MAME filename of the ROM image
+	Source   string   `json:"source"`             // Zip archive or directory it was found in
+	File     string   `json:"file"`               // Name of the matching file within Source
+	Size     uint64   `json:"size"`               // Size of the matching file
+	CRC      Checksum `json:"crc"`                // CRC32 checksum of the matching file
+	Mismatch bool     `json:"mismatch,omitempty"` // Whether the file was used despite the checksum differing
+}
+
+// Padding records where an area was padded because a ROM image was smaller
+// than the space it was given
+type Padding struct {
+	Area   int    `json:"area"`
+	Offset uint64 `json:"offset"`
+	Size   uint64 `json:"size"`
+}
+
+// ConversionReport describes how a File was created from a ROM set
+type ConversionReport struct {
+	Method      string      `json:"method"`
+	Game        string      `json:"game,omitempty"`
+	Parent      string      `json:"parent,omitempty"`
+	Reader      string      `json:"reader"`
+	Sources     []ROMSource `json:"sources"`
+	Padding     []Padding   `json:"padding,omitempty"`
+	Unverified  bool        `json:"unverified,omitempty"`  // Whether any ROM image didn't match its checksum
+	Placeholder []int       `json:"placeholder,omitempty"` // Areas containing filler for ROM images never dumped
+	Warnings    []string    `json:"warnings,omitempty"`
+}
+
+func (r *ConversionReport) warn(format string, a ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, a...))
+}
+
+func (r *ConversionReport) placeholder(area int) {
+	for _, a := range r.Placeholder {
+		if a == area {
+			return
+		}
+	}
+	r.Placeholder = append(r.Placeholder, area)
+}
+
+func (r *ConversionReport) pad(area int, offset, size uint64) {
+	if size > 0 {
+		r.Padding = append(r.Padding, Padding{area, offset, size})
+	}
+}
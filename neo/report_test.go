@@ -0,0 +1,181 @@
+package neo
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderNames(t *testing.T) {
+	for name, g := range mameGames {
+		_, ok := mameReaders[g.readerName]
+		assert.True(t, ok, name)
+	}
+
+	names := ReaderNames()
+	assert.Len(t, names, len(mameReaders))
+	assert.True(t, sort.StringsAreSorted(names))
+	assert.Contains(t, names, "common")
+}
+
+func TestConversionReport(t *testing.T) {
+	defer copyGames()()
+
+	f := testFile(0x40)
+	_, files := exportMAME(t, f, "not a game")
+
+	b := new(bytes.Buffer)
+	assert.Nil(t, f.ExportMAME(b, "not a game"))
+
+	dir := t.TempDir()
+	for _, name := range []string{"hack.zip", "testhack.zip"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), b.Bytes(), 0666))
+	}
+
+	_, report, err := Convert(context.Background(), filepath.Join(dir, "hack.zip"), nil)
+	if assert.Nil(t, err) {
+		assert.Equal(t, MethodGeneric, report.Method)
+		assert.Equal(t, "common", report.Reader)
+		assert.Len(t, report.Sources, len(files))
+	}
+
+	// The same ROM images known by their checksums
+	d := Definition{
+		Name:   "Test Hack",
+		Reader: "cmc42",
+		Areas:  make(map[string]DefinitionArea),
+	}
+	g := f.genericGame()
+	for i := 0; i < Areas; i++ {
+		var a DefinitionArea
+		for _, r := range g.area[i].rom {
+			crc := crc32.ChecksumIEEE(files[r.filename])
+			a.ROMs = append(a.ROMs, DefinitionROM{r.filename, r.size, hex.EncodeToString([]byte{byte(crc >> 24), byte(crc >> 16), byte(crc >> 8), byte(crc)})})
+		}
+		d.Areas[AreaName(i)] = a
+	}
+	assert.Nil(t, Define("testhack", d))
+
+	_, report, err = Convert(context.Background(), filepath.Join(dir, "testhack.zip"), nil)
+	if assert.Nil(t, err) {
+		assert.Equal(t, MethodMAME, report.Method)
+		assert.Equal(t, "testhack", report.Game)
+		assert.Equal(t, "cmc42", report.Reader)
+		if assert.Len(t, report.Sources, len(files)) {
+			s := report.Sources[0]
+			assert.Equal(t, P, s.Area)
+			assert.Equal(t, "p1.p1", s.Filename)
+			assert.Equal(t, filepath.Join(dir, "testhack.zip"), s.Source)
+			assert.Equal(t, d.Areas["P"].ROMs[0].CRC, s.CRC.String())
+		}
+
+		b, err := json.Marshal(report)
+		assert.Nil(t, err)
+
+		var m map[string]interface{}
+		assert.Nil(t, json.Unmarshal(b, &m))
+		assert.Equal(t, "mame", m["method"])
+		assert.Equal(t, "cmc42", m["reader"])
+		assert.Equal(t, d.Areas["P"].ROMs[0].CRC, m["sources"].([]interface{})[0].(map[string]interface{})["crc"])
+	}
+
+	// A clone that only renames the game uses the ROM images of its parent
+	assert.Nil(t, LoadDefinitions(strings.NewReader(`{"testhack2": {"parent": "testhack", "name": "Test Hack 2", "areas": {}}}`)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "testhack2.zip"), b.Bytes(), 0666))
+
+	n, report, err := Convert(context.Background(), filepath.Join(dir, "testhack2.zip"), nil)
+	if assert.Nil(t, err) {
+		assert.Equal(t, MethodMAME, report.Method)
+		assert.Equal(t, "testhack2", report.Game)
+		assert.Equal(t, "testhack", report.Parent)
+		assert.Equal(t, "cmc42", report.Reader)
+		assert.Len(t, report.Sources, len(files))
+		assert.Equal(t, "Test Hack 2", n.Name)
+	}
+}
+
+func TestCTHDPadding(t *testing.T) {
+	// Any padding of the M ROM is recorded in the report
+	a := mameArea{size: 0x20000, rom: []mameROM{{size: 0x8000}, {size: 0x10000}}}
+	tr := newTracker(context.Background(), nil)
+	b, err := cthdMReader(tr, a, []io.Reader{bytes.NewReader(make([]byte, 0x8000)), bytes.NewReader(make([]byte, 0x10000))})
+	assert.Nil(t, err)
+	assert.Len(t, b, 0x20000)
+	assert.Equal(t, []Padding{{M, 0x8000, 0x8000}}, tr.result.Padding)
+}
+
+func TestPlaceholder(t *testing.T) {
+	defer copyGames()()
+
+	f := testFile(0x40)
+	_, files := exportMAME(t, f, "not a game")
+
+	// The S ROM image was never dumped
+	dir := t.TempDir()
+	set := filepath.Join(dir, "testnd")
+	assert.Nil(t, os.Mkdir(set, 0777))
+
+	dataAreas := []string{"maincpu", "fixed", "audiocpu", "ymsnd", "ymsnd.deltat", "sprites"}
+	softwareList := `<softwarelists><softwarelist name="neogeo"><software name="testnd"><description>Test</description><part name="cart" interface="neo_cart">`
+	g := f.genericGame()
+	for i := 0; i < Areas; i++ {
+		softwareList += fmt.Sprintf(`<dataarea name="%s" size="0x%x">`, dataAreas[i], g.area[i].size)
+		for _, r := range g.area[i].rom {
+			if i == S {
+				softwareList += `<rom size="0x80" offset="0" value="0xff" loadflag="fill" />`
+				softwareList += fmt.Sprintf(`<rom name="%s" size="0x%x" status="nodump" />`, r.filename, r.size)
+				continue
+			}
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(set, r.filename), files[r.filename], 0666))
+			softwareList += fmt.Sprintf(`<rom name="%s" size="0x%x" crc="%08x" />`, r.filename, r.size, crc32.ChecksumIEEE(files[r.filename]))
+		}
+		softwareList += `</dataarea>`
+	}
+	softwareList += `</part></software></softwarelist></softwarelists>`
+
+	assert.Nil(t, LoadSoftwareList(strings.NewReader(softwareList)))
+	assert.Equal(t, uint8(0xff), mameGames["testnd"].area[S].fill)
+
+	n, report, err := Convert(context.Background(), set, nil)
+	if assert.Nil(t, err) {
+		assert.Equal(t, bytes.Repeat([]byte{0xff}, len(f.ROM[S])), n.ROM[S])
+		assert.Equal(t, f.ROM[C], n.ROM[C])
+		assert.Equal(t, []int{S}, report.Placeholder)
+		assert.False(t, report.Unverified)
+
+		b, err := json.Marshal(report)
+		assert.Nil(t, err)
+		assert.Contains(t, string(b), `"placeholder":[1]`)
+	}
+
+	// A file supplied for the ROM image is used instead
+	nodump := filepath.Join(dir, "s1.bin")
+	assert.Nil(t, ioutil.WriteFile(nodump, f.ROM[S], 0666))
+
+	n, report, err = Convert(context.Background(), set, &Options{
+		Nodump: map[string]string{"s1.s1": nodump},
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, f.ROM[S], n.ROM[S])
+		assert.Empty(t, report.Placeholder)
+		assert.True(t, report.Unverified)
+	}
+
+	assert.Nil(t, ioutil.WriteFile(nodump, f.ROM[S][1:], 0666))
+	_, _, err = Convert(context.Background(), set, &Options{
+		Nodump: map[string]string{"s1.s1": nodump},
+	})
+	assert.NotNil(t, err)
+}
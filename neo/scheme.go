@@ -0,0 +1,160 @@
+package neo
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	errInvalidScheme = errors.New("neo: invalid decryption scheme")
+	errSchemeSizes   = errors.New("neo: ROM sizes do not suit the decryption scheme")
+)
+
+// smaReaders are the games with an SMA protected P ROM, the scheme used by
+// hacks and prototypes of them is named after the game
+var smaReaders = map[string]gameReader{
+	"garou":   garou,
+	"garouh":  garouh,
+	"kof99":   kof99,
+	"kof2000": kof2000,
+	"mslug3":  mslug3,
+	"mslug3a": mslug3a,
+}
+
+// Scheme is how to decrypt a ROM set that isn't known to MAME, such as a
+// hack or prototype of an encrypted game
+type Scheme struct {
+	// Name is one of cmc42, cmc50, pcm2 or sma
+	Name string
+	// GfxKey is the XOR key for the C ROM, it isn't used by sma which
+	// uses the key for the game
+	GfxKey int
+	// M1 decrypts the M ROM the same as CMC50 protected games
+	M1 bool
+	// Value is the size of the blocks swapped in the V ROM by pcm2
+	Value int
+	// Game is the game whose P ROM protection is used by sma
+	Game string
+}
+
+// ParseScheme parses a scheme name such as cmc42, cmc50, pcm2:8 or
+// sma:kof99. The key and whether the M ROM is decrypted are left unset
+func ParseScheme(s string) (*Scheme, error) {
+	scheme := new(Scheme)
+
+	var arg string
+	scheme.Name = s
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		scheme.Name, arg = s[:i], s[i+1:]
+	}
+
+	switch scheme.Name {
+	case "cmc42", "cmc50":
+		if arg != "" {
+			return nil, errInvalidScheme
+		}
+	case "pcm2":
+		v, err := strconv.ParseUint(arg, 0, 16)
+		if err != nil || v < 2 || v&(v-1) != 0 {
+			return nil, errInvalidScheme
+		}
+		scheme.Value = int(v)
+	case "sma":
+		if _, ok := smaReaders[arg]; !ok {
+			return nil, errInvalidScheme
+		}
+		scheme.Game = arg
+	default:
+		return nil, errInvalidScheme
+	}
+
+	return scheme, nil
+}
+
+func (s *Scheme) String() string {
+	switch s.Name {
+	case "pcm2":
+		return s.Name + ":" + strconv.Itoa(s.Value)
+	case "sma":
+		return s.Name + ":" + s.Game
+	default:
+		return s.Name
+	}
+}
+
+// checkSizes reports whether the areas of g are big enough and sized so the
+// scheme can decode them without reading past the end of an area
+func (s *Scheme) checkSizes(g mameGame, sfix bool) error {
+	if len(g.area[P].rom) == 0 {
+		return errSchemeSizes
+	}
+	switch s.Name {
+	case "cmc42", "cmc50", "pcm2":
+		if len(g.area[C].rom)%2 != 0 || (sfix && g.area[C].interleavedSize() < g.area[S].size) {
+			return errSchemeSizes
+		}
+	}
+	if s.Name == "pcm2" && g.area[V1].paddedSize()%uint64(s.Value) != 0 {
+		return errSchemeSizes
+	}
+	return nil
+}
+
+// read decodes a ROM set using the scheme. The S ROM is extracted from the
+// C ROM as for the encrypted games if there isn't one
+func (s *Scheme) read(f *File, g mameGame, readers [][]io.Reader) error {
+	// The M ROM is read separately so any scheme can be used with or
+	// without it being encrypted
+	m, mr := g.area[M], readers[M]
+	g.area[M] = mameArea{}
+	readers = append([][]io.Reader(nil), readers...)
+	readers[M] = nil
+
+	// The S ROM is also read separately as the readers for the encrypted
+	// games always extract it from the C ROM
+	sa, sr := g.area[S], readers[S]
+	sfix := len(sa.rom) == 0
+	g.area[S] = mameArea{size: sa.size}
+	if sfix {
+		g.area[S].size = oneTwentyEightKB
+	}
+	readers[S] = nil
+
+	if err := s.checkSizes(g, sfix); err != nil {
+		return err
+	}
+
+	var err error
+	switch s.Name {
+	case "cmc42":
+		err = commonCMC42Reader(f, g, readers, s.GfxKey)
+	case "cmc50":
+		err = commonCMC50Reader(f, g, readers, s.GfxKey)
+	case "pcm2":
+		err = commonPCM2Reader(f, g, readers, s.GfxKey, sfix, s.Value)
+	case "sma":
+		err = smaReaders[s.Game](f, g, readers)
+	default:
+		err = errInvalidScheme
+	}
+	if err != nil {
+		return err
+	}
+
+	if !sfix {
+		if f.ROM[S], err = commonPaddedReader(f.tracker, S, sa, sr); err != nil {
+			return err
+		}
+	}
+
+	if f.ROM[M], err = commonPaddedReader(f.tracker, M, m, mr); err != nil {
+		return err
+	}
+	if s.M1 {
+		f.ROM[M] = cmc50M1Decrypt(f.tracker, f.ROM[M])
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package neo
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScheme(t *testing.T) {
+	tables := []struct {
+		s      string
+		scheme *Scheme
+		err    error
+	}{
+		{"cmc42", &Scheme{Name: "cmc42"}, nil},
+		{"cmc50", &Scheme{Name: "cmc50"}, nil},
+		{"pcm2:8", &Scheme{Name: "pcm2", Value: 8}, nil},
+		{"sma:kof99", &Scheme{Name: "sma", Game: "kof99"}, nil},
+		{"cmc42:1", nil, errInvalidScheme},
+		{"pcm2", nil, errInvalidScheme},
+		{"pcm2:3", nil, errInvalidScheme},
+		{"sma:mslug", nil, errInvalidScheme},
+		{"pvc", nil, errInvalidScheme},
+	}
+
+	for _, table := range tables {
+		scheme, err := ParseScheme(table.s)
+		assert.Equal(t, table.err, err)
+		assert.Equal(t, table.scheme, scheme)
+		if scheme != nil {
+			assert.Equal(t, table.s, scheme.String())
+		}
+	}
+}
+
+func TestSchemeRead(t *testing.T) {
+	g := mameGame{}
+	roms := make([][][]byte, Areas)
+	for _, i := range []int{P, M, V1, C, C} {
+		b := make([]byte, 0x20000)
+		for j := range b {
+			b[j] = byte(i*3 + j*7 + len(roms[i]))
+		}
+		roms[i] = append(roms[i], b)
+		g.area[i].size += uint64(len(b))
+		g.area[i].rom = append(g.area[i].rom, mameROM{size: uint64(len(b))})
+	}
+
+	readers := func() [][]io.Reader {
+		readers := make([][]io.Reader, Areas)
+		for i := range roms {
+			for _, b := range roms[i] {
+				readers[i] = append(readers[i], bytes.NewReader(b))
+			}
+		}
+		return readers
+	}
+
+	// CMC50 decrypts the M ROM as well
+	expected := new(File)
+	sg := g
+	sg.area[S].size = oneTwentyEightKB
+	assert.Nil(t, commonCMC50Reader(expected, sg, readers(), 0x12))
+
+	f := new(File)
+	assert.Nil(t, (&Scheme{Name: "cmc50", GfxKey: 0x12, M1: true}).read(f, g, readers()))
+	assert.Equal(t, expected.ROM, f.ROM)
+
+	f = new(File)
+	assert.Nil(t, (&Scheme{Name: "cmc50", GfxKey: 0x12}).read(f, g, readers()))
+	assert.Equal(t, roms[M][0], f.ROM[M])
+	assert.Equal(t, expected.ROM[C], f.ROM[C])
+
+	// A supplied S ROM is kept rather than extracted from the C ROM
+	sfix := bytes.Repeat([]byte{0x5a}, 0x20000)
+	g.area[S] = mameArea{size: uint64(len(sfix)), rom: []mameROM{{size: uint64(len(sfix))}}}
+	roms[S] = [][]byte{sfix}
+
+	for _, scheme := range []string{"cmc42", "cmc50", "pcm2:8"} {
+		s, err := ParseScheme(scheme)
+		if !assert.Nil(t, err) {
+			continue
+		}
+		f = new(File)
+		assert.Nil(t, s.read(f, g, readers()), scheme)
+		assert.Equal(t, sfix, f.ROM[S], scheme)
+		assert.Equal(t, roms[M][0], f.ROM[M], scheme)
+	}
+
+	// Sizes that would read past the end of an area are refused
+	small := mameGame{}
+	assert.Equal(t, errSchemeSizes, (&Scheme{Name: "cmc42"}).read(new(File), small, make([][]io.Reader, Areas)))
+
+	small.area[P] = mameArea{size: 0x1000, rom: []mameROM{{size: 0x1000}}}
+	small.area[V1] = mameArea{size: 0x1000, rom: []mameROM{{size: 0x1000}}}
+	small.area[C] = mameArea{size: 0x2000, rom: []mameROM{{size: 0x1000}, {size: 0x1000}}}
+	smallReaders := func() [][]io.Reader {
+		readers := make([][]io.Reader, Areas)
+		readers[P] = []io.Reader{bytes.NewReader(make([]byte, 0x1000))}
+		readers[V1] = []io.Reader{bytes.NewReader(make([]byte, 0x1000))}
+		readers[C] = []io.Reader{bytes.NewReader(make([]byte, 0x1000)), bytes.NewReader(make([]byte, 0x1000))}
+		return readers
+	}
+
+	for _, scheme := range []string{"cmc42", "cmc50", "pcm2:8"} {
+		s, err := ParseScheme(scheme)
+		if assert.Nil(t, err) {
+			assert.Equal(t, errSchemeSizes, s.read(new(File), small, smallReaders()), scheme)
+		}
+	}
+
+	// With an S ROM the C ROM can be any size
+	small.area[S] = mameArea{size: 0x1000, rom: []mameROM{{size: 0x1000}}}
+	readersS := func() [][]io.Reader {
+		readers := smallReaders()
+		readers[S] = []io.Reader{bytes.NewReader(make([]byte, 0x1000))}
+		return readers
+	}
+	f = new(File)
+	assert.Nil(t, (&Scheme{Name: "pcm2", Value: 8}).read(f, small, readersS()))
+	assert.Equal(t, errSchemeSizes, (&Scheme{Name: "pcm2", Value: 0x8000}).read(new(File), small, readersS()))
+}
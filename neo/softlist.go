@@ -0,0 +1,127 @@
+package neo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/bodgit/terraonion/internal/softlist"
+)
+
+// genreByName returns the genre with the given name
+func genreByName(name string) Genre {
+	for g := Other; g <= Puzzle; g++ {
+		if g.String() == name {
+			return g
+		}
+	}
+	return Other
+}
+
+// softwareGame converts a game in a software list to an entry in the table
+// of known games the same as the generator does
+func softwareGame(s softlist.Software) (mameEntry, error) {
+	game := mameEntry{
+		name:         s.Description,
+		year:         s.Year,
+		manufacturer: s.Publisher,
+		genre:        genreByName(s.Genre()),
+		screenshot:   uint32(s.Screenshot()),
+		readerName:   s.Reader(),
+	}
+	game.parent = s.CloneOf
+
+	var ok bool
+	if game.reader, ok = mameReaders[s.Reader()]; !ok {
+		return game, fmt.Errorf("neo: %s: unknown reader %s", s.Name, s.Reader())
+	}
+
+	for i, name := range softlist.Areas {
+		da := s.FindDataArea(name)
+		if da == nil {
+			continue
+		}
+
+		game.area[i].size, game.area[i].fill = uint64(da.Size), da.Fill()
+		for _, r := range da.ROM {
+			if r.Name == "" {
+				continue
+			}
+
+			rom := mameROM{filename: r.Name, size: uint64(r.Size)}
+			if !r.IsNodump() {
+				crc, err := hex.DecodeString(r.CRC)
+				if err != nil {
+					return game, fmt.Errorf("neo: %s: invalid CRC %s for %s", s.Name, r.CRC, r.Name)
+				}
+				rom.crc = crc
+			}
+			game.area[i].rom = append(game.area[i].rom, rom)
+		}
+	}
+
+	return game, nil
+}
+
+// ReaderNames returns the name of every reader a game in a software list can
+// use in name order
+func ReaderNames() []string {
+	names := make([]string, 0, len(mameReaders))
+	for name := range mameReaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadSoftwareList reads a MAME software list such as neogeo.xml from r and
+// adds every supported game in it to the games known to MAME, replacing any
+// built-in game with the same name. Any game the list marks as unsupported
+// or moves to a slot that can't be read is removed. Games the list doesn't
+// mention are kept. It must not be called while converting
+func LoadSoftwareList(r io.Reader) error {
+	lists, err := softlist.Read(r)
+	if err != nil {
+		return err
+	}
+
+	games := make(map[string]mameEntry)
+	var unsupported []string
+
+	for _, list := range lists.SoftwareList {
+		for _, s := range list.Software {
+			if !s.IsSupported() {
+				unsupported = append(unsupported, s.Name)
+				continue
+			}
+
+			game, err := softwareGame(s)
+			if err != nil {
+				return err
+			}
+
+			games[s.Name] = game
+		}
+	}
+
+	for _, name := range unsupported {
+		delete(mameGames, name)
+	}
+	for name, game := range games {
+		mameGames[name] = game
+	}
+
+	// Existing NGH numbers are kept unless the game is no longer known
+	for ngh, name := range lists.GamesByNGH() {
+		if _, ok := mameGames[mameNGH[ngh]]; !ok {
+			mameNGH[ngh] = name
+		}
+	}
+
+	// Rebuild the checksum index the next time it's used
+	crcGamesOnce, crcGames = sync.Once{}, nil
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package neo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSoftwareList(t *testing.T) {
+	defer copyGames()()
+
+	softwareList := `<softwarelists>
+	<softwarelist name="neogeo">
+		<software name="kof99e" cloneof="kof99" supported="yes">
+			<description>The King of Fighters '99 (corrected dump)</description>
+			<year>1999</year>
+			<publisher>SNK</publisher>
+			<part name="cart" interface="neo_cart">
+				<feature name="slot" value="sma_kof99" />
+				<dataarea name="maincpu" width="16" endianness="big" size="0x900000">
+					<rom name="ka.neo-sma" size="0x040000" crc="7766D09E" />
+					<rom name="251-p1.p1" size="0x400000" crc="006E4532" />
+				</dataarea>
+				<dataarea name="audiocrypt" size="0x20000">
+					<rom name="251-m1.m1" size="0x20000" status="nodump" />
+				</dataarea>
+			</part>
+		</software>
+		<software name="kof2001" supported="no">
+			<description>The King of Fighters 2001 (NGM-262?)(NGH-2621)</description>
+			<part name="cart" interface="neo_cart">
+				<feature name="slot" value="cmc50_kof2001" />
+			</part>
+		</software>
+		<software name="kof2001h" cloneof="kof2001">
+			<description>The King of Fighters 2001 (NGH-2621)</description>
+			<part name="cart" interface="neo_cart">
+				<feature name="slot" value="cmc50_kof2001" />
+				<dataarea name="maincpu" width="16" endianness="big" size="0x500000">
+					<rom name="262-pg1.p1" size="0x100000" crc="2da8d8cf" />
+				</dataarea>
+			</part>
+		</software>
+		<software name="kof10thnew" cloneof="kof98">
+			<description>The King of Fighters 10th Anniversary (corrected dump)</description>
+			<part name="cart" interface="neo_cart">
+				<feature name="slot" value="boot_kf10th_new" />
+			</part>
+		</software>
+	</softwarelist>
+</softwarelists>`
+
+	assert.Nil(t, LoadSoftwareList(strings.NewReader(softwareList)))
+
+	g, ok := mameGames["kof99e"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "kof99", g.parent)
+		assert.Equal(t, "The King of Fighters '99 (corrected dump)", g.name)
+		assert.Equal(t, Fighting, g.genre)
+		assert.Equal(t, "kof99", mameGames["kof99e"].readerName)
+		assert.Equal(t, uint64(0x900000), g.area[P].size)
+		assert.Equal(t, []byte{0x77, 0x66, 0xd0, 0x9e}, g.area[P].rom[0].crc)
+		assert.True(t, g.area[M].rom[0].nodump())
+		assert.Empty(t, g.area[C].rom)
+	}
+
+	// Games that are unsupported or in an unsupported slot are removed,
+	// those not in the list are kept
+	for _, name := range []string{"kof10thnew", "kof2001"} {
+		_, ok = mameGames[name]
+		assert.False(t, ok, name)
+	}
+	for _, name := range []string{"kof99", "maglord"} {
+		_, ok = mameGames[name]
+		assert.True(t, ok, name)
+	}
+
+	// Existing NGH numbers are kept and those of removed games replaced
+	assert.Equal(t, "kof99", mameNGH[0x251])
+	assert.Equal(t, "maglord", mameNGH[0x005])
+	assert.Equal(t, "kof2001h", mameNGH[0x262])
+	assert.ElementsMatch(t, []string{"kof99", "kof99e"}, gamesByCRC()[string([]byte{0x77, 0x66, 0xd0, 0x9e})])
+}
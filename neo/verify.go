@@ -0,0 +1,125 @@
+package neo
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNoChecksums is returned when verifying against a game with no expected
+// checksums. None are built in until they have been checked against another
+// builder so they usually need loading with LoadChecksums first
+var ErrNoChecksums = errors.New("neo: no expected checksums for game")
+
+// VerifyError lists the ROM areas that do not match the expected checksums
+// for a game
+type VerifyError struct {
+	Game  string
+	Areas []int
+}
+
+func (e *VerifyError) Error() string {
+	areas := make([]string, len(e.Areas))
+	for i, area := range e.Areas {
+		areas[i] = AreaName(area)
+	}
+	return fmt.Sprintf("neo: %s differs from %s", strings.Join(areas, ", "), e.Game)
+}
+
+// LoadChecksums reads lines of "game area sha1" from r, the same format as
+// sha1.txt, and adds them to the expected checksums. It must not be called
+// while verifying
+func LoadChecksums(r io.Reader) error {
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) != 3 {
+			return fmt.Errorf("neo: line %d: expected 3 fields", line)
+		}
+
+		area := -1
+		for i := 0; i < Areas; i++ {
+			if AreaName(i) == fields[1] {
+				area = i
+			}
+		}
+		if area < 0 {
+			return fmt.Errorf("neo: line %d: unknown area %q", line, fields[1])
+		}
+
+		b, err := hex.DecodeString(fields[2])
+		if err != nil || len(b) != sha1.Size {
+			return fmt.Errorf("neo: line %d: invalid SHA1 %q", line, fields[2])
+		}
+
+		checksums := mameSHA1[fields[0]]
+		checksums[area] = b
+		mameSHA1[fields[0]] = checksums
+	}
+
+	return s.Err()
+}
+
+func verify(game string, readers [Areas]io.Reader) error {
+	checksums, ok := mameSHA1[game]
+	if !ok {
+		return ErrNoChecksums
+	}
+
+	var areas []int
+	for i := 0; i < Areas; i++ {
+		// Not every area of every game has a known checksum
+		if checksums[i] == nil {
+			continue
+		}
+
+		h := sha1.New()
+		if _, err := io.Copy(h, readers[i]); err != nil {
+			return err
+		}
+
+		if !bytes.Equal(h.Sum(nil), checksums[i]) {
+			areas = append(areas, i)
+		}
+	}
+
+	if len(areas) > 0 {
+		return &VerifyError{
+			Game:  game,
+			Areas: areas,
+		}
+	}
+
+	return nil
+}
+
+// Verify compares the decrypted ROM areas against the checksums expected
+// for the named MAME game. A *VerifyError is returned listing any areas that
+// differ
+func (f *File) Verify(game string) error {
+	var readers [Areas]io.Reader
+	for i := 0; i < Areas; i++ {
+		readers[i] = bytes.NewReader(f.ROM[i])
+	}
+	return verify(game, readers)
+}
+
+// Verify compares the decrypted ROM areas against the checksums expected
+// for the named MAME game. A *VerifyError is returned listing any areas that
+// differ
+func (r *Reader) Verify(game string) error {
+	var readers [Areas]io.Reader
+	for i := 0; i < Areas; i++ {
+		readers[i] = io.NewSectionReader(r.ROM[i], 0, r.ROM[i].Size())
+	}
+	return verify(game, readers)
+}
@@ -0,0 +1,55 @@
+package neo
+
+import (
+	"crypto/sha1"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	f := new(File)
+	f.ROM[P] = []byte{0x01, 0x02}
+	f.ROM[C] = []byte{0x03, 0x04}
+
+	p := sha1.Sum(f.ROM[P])
+	mameSHA1["test"] = [Areas][]byte{P: p[:], C: p[:]}
+	defer delete(mameSHA1, "test")
+
+	var verr *VerifyError
+	err := f.Verify("test")
+	assert.True(t, errors.As(err, &verr))
+	assert.Equal(t, []int{C}, verr.Areas)
+	assert.Equal(t, "neo: C differs from test", err.Error())
+
+	f.ROM[C] = f.ROM[P]
+	assert.Nil(t, f.Verify("test"))
+
+	assert.Equal(t, ErrNoChecksums, f.Verify("unknown"))
+}
+
+func TestLoadChecksums(t *testing.T) {
+	f := new(File)
+	f.ROM[P] = []byte{0x01, 0x02}
+	f.ROM[C] = []byte{0x03, 0x04}
+
+	assert.Equal(t, ErrNoChecksums, f.Verify("test"))
+
+	defer delete(mameSHA1, "test")
+	assert.Nil(t, LoadChecksums(strings.NewReader(`# Comment
+
+test P 0ca623e2855f2c75c842ad302fe820e41b4d197d
+test C 1c0dff7b3ab7e3b3b3d3fd8a5f7f8b2bc2d1a3c4
+`)))
+
+	var verr *VerifyError
+	err := f.Verify("test")
+	assert.True(t, errors.As(err, &verr))
+	assert.Equal(t, []int{C}, verr.Areas)
+
+	assert.NotNil(t, LoadChecksums(strings.NewReader("test P\n")))
+	assert.NotNil(t, LoadChecksums(strings.NewReader("test X 0ca623e2855f2c75c842ad302fe820e41b4d197d\n")))
+	assert.NotNil(t, LoadChecksums(strings.NewReader("test P 0ca623e2\n")))
+}